@@ -0,0 +1,156 @@
+package main
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/hajimehoshi/ebiten/v2"
+	"github.com/hajimehoshi/ebiten/v2/inpututil"
+
+	"github.com/game-jam-2026/dead-jump/internal/demo"
+	"github.com/game-jam-2026/dead-jump/internal/ecs"
+	"github.com/game-jam-2026/dead-jump/internal/ecs/components"
+	"github.com/game-jam-2026/dead-jump/internal/ecs/systems"
+	"github.com/game-jam-2026/dead-jump/internal/game"
+	"github.com/game-jam-2026/dead-jump/internal/input"
+	"github.com/game-jam-2026/dead-jump/internal/levels"
+	"github.com/game-jam-2026/dead-jump/internal/menu"
+	"github.com/game-jam-2026/dead-jump/internal/utils/audio"
+)
+
+// attractDelay is how long the title screen waits for input before a demo
+// starts playing behind it.
+const attractDelay = 20 * ebiten.DefaultTPS
+
+// attract is a demo playing behind the title screen.
+type attract struct {
+	w     *ecs.World
+	input *input.Playback
+}
+
+// updateAttract runs attract mode on the title screen. It returns true when
+// it consumed the frame's input to stop a demo.
+func (g *Game) updateAttract(state menu.GameState) bool {
+	if state != menu.StateMenu {
+		g.stopAttract()
+		return false
+	}
+
+	if anyInput() {
+		g.idleTicks = 0
+		if g.attract != nil {
+			g.stopAttract()
+			return true
+		}
+		return false
+	}
+
+	if g.attract == nil {
+		g.idleTicks++
+		if g.idleTicks >= attractDelay {
+			g.startAttract()
+		}
+		return false
+	}
+
+	a := g.attract
+	input.Apply(a.w, a.input.Poll())
+	simulate(a.w)
+	followCharacter(a.w)
+
+	// демки крутятся по кругу, пока кто-нибудь не нажмёт кнопку
+	if a.input.Done() || isGameOver(a.w) || systems.ApplyLevelFinish(a.w) {
+		g.stopAttract()
+		g.startAttract()
+	}
+	return false
+}
+
+func (g *Game) startAttract() {
+	d, ok := demo.Random()
+	if !ok {
+		return
+	}
+
+	level, ok := levels.Find(d.Level)
+	if !ok {
+		fmt.Println("demo: unknown level", d.Level)
+		return
+	}
+
+	g.attract = &attract{
+		w:     level.Load(demoProfile()),
+		input: input.NewPlayback(d.Ticks()),
+	}
+	g.menu.SetOverWorld(true)
+	audio.SetQuietSFX(true)
+}
+
+func (g *Game) stopAttract() {
+	g.idleTicks = 0
+	if g.attract == nil {
+		return
+	}
+
+	g.attract = nil
+	g.menu.SetOverWorld(false)
+	audio.SetQuietSFX(false)
+}
+
+func (g *Game) drawAttract(screen *ebiten.Image) {
+	if g.attract == nil {
+		return
+	}
+	camera, _ := ecs.GetResource[components.Camera](g.attract.w)
+	systems.DrawSpritesWithCamera(g.attract.w, screen, camera)
+}
+
+func anyInput() bool {
+	return len(inpututil.AppendJustPressedKeys(nil)) > 0 ||
+		inpututil.IsMouseButtonJustPressed(ebiten.MouseButtonLeft) ||
+		len(inpututil.AppendJustConnectedGamepadIDs(nil)) > 0
+}
+
+// startDemoRecording reloads the current level the way attract mode loads it
+// and records the player's buttons from the first tick.
+func (g *Game) startDemoRecording() (string, error) {
+	level := g.levelManager.Current()
+	if level.Load == nil {
+		return "", fmt.Errorf("no level is being played")
+	}
+
+	g.w = level.Load(demoProfile())
+	g.speedrun.Stop()
+	g.recording = input.NewRecorder(input.Keyboard{})
+	g.input = g.recording
+	g.menu.SetState(menu.StatePlaying)
+	return level.Name, nil
+}
+
+// stopDemoRecording writes the recording as a demo file; bundled demos live
+// in internal/demo/demos.
+func (g *Game) stopDemoRecording(path string) (string, error) {
+	if g.recording == nil {
+		return "", fmt.Errorf("not recording")
+	}
+
+	level := g.levelManager.Current().Name
+	d := demo.New(level, g.recording.Ticks)
+	g.input = g.recording.Source
+	g.recording = nil
+
+	data, err := d.Marshal()
+	if err != nil {
+		return "", err
+	}
+	if path == "" {
+		path = level + ".json"
+	}
+	return path, os.WriteFile(path, data, 0o644)
+}
+
+// demoProfile is the difficulty demos are recorded and replayed on, whatever
+// the player picked, so they play out as recorded.
+func demoProfile() game.Profile {
+	return game.ProfileFor(game.DifficultyNormal)
+}
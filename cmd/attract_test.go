@@ -0,0 +1,43 @@
+package main
+
+import (
+	"testing"
+
+	"github.com/game-jam-2026/dead-jump/internal/demo"
+	"github.com/game-jam-2026/dead-jump/internal/ecs/systems"
+	"github.com/game-jam-2026/dead-jump/internal/input"
+	"github.com/game-jam-2026/dead-jump/internal/levels"
+)
+
+// Every bundled demo has to reach its level's finish the way attract mode
+// replays it; a physics change that desyncs one fails here.
+func TestBundledDemosFinish(t *testing.T) {
+	demos := demo.Bundled()
+	if len(demos) == 0 {
+		t.Fatal("no bundled demos")
+	}
+
+	for _, d := range demos {
+		t.Run(d.Level, func(t *testing.T) {
+			level, ok := levels.Find(d.Level)
+			if !ok {
+				t.Fatalf("unknown level %q", d.Level)
+			}
+
+			w := level.Load(demoProfile())
+			playback := input.NewPlayback(d.Ticks())
+			for tick := 0; !playback.Done(); tick++ {
+				input.Apply(w, playback.Poll())
+				simulate(w)
+
+				if systems.ApplyLevelFinish(w) {
+					return
+				}
+				if isGameOver(w) {
+					t.Fatalf("game over on tick %d", tick)
+				}
+			}
+			t.Fatalf("finish not reached in %d ticks", len(playback.Ticks))
+		})
+	}
+}
@@ -1,18 +1,30 @@
 package main
 
 import (
+	"fmt"
 	"log"
 	"os"
 	"reflect"
+	"strings"
 
 	"github.com/game-jam-2026/dead-jump/internal/assets"
+	"github.com/game-jam-2026/dead-jump/internal/assets/registry"
+	"github.com/game-jam-2026/dead-jump/internal/console"
+	"github.com/game-jam-2026/dead-jump/internal/debug"
 	"github.com/game-jam-2026/dead-jump/internal/ecs"
 	"github.com/game-jam-2026/dead-jump/internal/ecs/components"
 	"github.com/game-jam-2026/dead-jump/internal/ecs/systems"
+	"github.com/game-jam-2026/dead-jump/internal/editor"
+	"github.com/game-jam-2026/dead-jump/internal/game"
+	"github.com/game-jam-2026/dead-jump/internal/ghost"
+	"github.com/game-jam-2026/dead-jump/internal/hud"
+	"github.com/game-jam-2026/dead-jump/internal/input"
 	"github.com/game-jam-2026/dead-jump/internal/levels"
 	"github.com/game-jam-2026/dead-jump/internal/menu"
 	"github.com/game-jam-2026/dead-jump/internal/physics"
+	"github.com/game-jam-2026/dead-jump/internal/speedrun"
 	"github.com/game-jam-2026/dead-jump/internal/utils"
+	"github.com/game-jam-2026/dead-jump/pkg/linalg"
 
 	"github.com/hajimehoshi/ebiten/v2"
 	"github.com/hajimehoshi/ebiten/v2/inpututil"
@@ -22,42 +34,106 @@ type Game struct {
 	w            *ecs.World
 	menu         *menu.Menu
 	levelManager *levels.Manager
+	hud          *hud.HUD
+	speedrun     *speedrun.Timer
+
+	input     input.Source
+	recording *input.Recorder
+	attract   *attract
+	idleTicks int
+	// speedClock accumulates the game speed assist between frames
+	speedClock float64
+
+	editor      *editor.Editor
+	console     *console.Console
+	playtesting bool
+
+	showDebugOverlay bool
 }
 
 func NewGame() *Game {
-	g := &Game{}
+	g := &Game{input: input.Keyboard{}}
 
 	// Initialize audio context and register all sounds
 	assets.InitAudio()
 
 	g.levelManager = levels.NewManager()
+	g.speedrun = speedrun.NewTimer()
+	g.hud = hud.New()
+	g.hud.Add(hud.NewRunTimer(g.speedrun), hud.AnchorBottomCenter, linalg.Vector2{Y: 6})
+
+	if debug.Enabled {
+		g.editor = editor.New(editor.DefaultPath)
+		g.console = console.New(console.Hooks{
+			World: func() *ecs.World { return g.w },
+			SetWorld: func(w *ecs.World) {
+				g.w = w
+				g.menu.SetState(menu.StatePlaying)
+			},
+			RecordDemo: g.startDemoRecording,
+			StopDemo:   g.stopDemoRecording,
+			JumpLevel: func(index int) (*ecs.World, error) {
+				w, err := g.levelManager.JumpTo(index)
+				if err != nil {
+					return nil, err
+				}
+				g.playtesting = false
+				g.speedrun.Stop()
+				return w, nil
+			},
+		})
+	}
 
 	g.menu = menu.NewMenu()
 	g.menu.OnStartGame = func() {
+		g.playtesting = false
 		g.w = g.levelManager.StartGame()
 		g.menu.SetState(menu.StatePlaying)
+		if game.IsSpeedrunTimerOn() {
+			category := game.GetDifficulty().String()
+			if game.CurrentAssists().Any() {
+				category += "-assist"
+			}
+			g.speedrun.Start(category)
+		}
 	}
 	g.menu.OnRestart = func() {
-		g.w = g.levelManager.RestartLevel()
+		if g.playtesting {
+			g.w = g.editor.Playtest()
+			return
+		}
+		g.w = g.levelManager.RestartLevel(g.w)
+	}
+	g.menu.OnContinue = func() {
+		g.w = g.levelManager.Continue()
 	}
 	g.menu.OnNextLevel = func() {
 		g.w = g.levelManager.NextLevel()
 		if g.w == nil {
-			g.menu.SetState(menu.StateMenu)
-			g.levelManager.Reset()
+			g.menu.ShowCredits()
 		}
 	}
 	g.menu.OnResume = func() {
 		g.menu.SetState(menu.StatePlaying)
 	}
 	g.menu.OnMainMenu = func() {
+		g.playtesting = false
 		g.w = nil
 		g.levelManager.Reset()
+		g.speedrun.Stop()
 		g.menu.SetState(menu.StateMenu)
 	}
 	g.menu.OnQuit = func() {
 		os.Exit(0)
 	}
+	g.menu.OnExportSplits = func() {
+		paths, err := g.speedrun.Export()
+		if err != nil {
+			fmt.Println("speedrun: export failed:", err)
+			return
+		}
+		fmt.Println("speedrun: exported", strings.Join(paths, ", "))
+	}
 	g.menu.OnEpilogueComplete = func() {
 		g.levelManager.Reset()
 		g.w = g.levelManager.StartGame()
@@ -69,8 +145,22 @@ func NewGame() *Game {
 func (g *Game) Update() error {
 	state := g.menu.GetState()
 
+	registry.PollHotReload()
+	g.importGhosts()
 	systems.UpdateLevelMusic(state)
 
+	if debug.Enabled && inpututil.IsKeyJustPressed(ebiten.KeyF3) {
+		g.showDebugOverlay = !g.showDebugOverlay
+	}
+
+	if g.console != nil && g.console.Update() {
+		return nil
+	}
+
+	if g.editor != nil && g.updateEditor() {
+		return nil
+	}
+
 	// Handle ESC key
 	if inpututil.IsKeyJustPressed(ebiten.KeyEscape) {
 		switch state {
@@ -83,53 +173,190 @@ func (g *Game) Update() error {
 		}
 	}
 
+	if g.updateAttract(state) {
+		return nil
+	}
+
+	if g.countsMenuTime(state) {
+		g.speedrun.Tick()
+	}
+
 	// Update based on state
 	switch state {
-	case menu.StateMenu, menu.StatePaused, menu.StateConfirmRestart, menu.StateSettings, menu.StateLevelComplete, menu.StateGameOver, menu.StateEpilogueEnding, menu.StateDifficultySelect:
+	case menu.StateMenu, menu.StatePaused, menu.StateConfirmRestart, menu.StateSettings, menu.StateLevelComplete, menu.StateGameOver, menu.StateEpilogueEnding, menu.StateCredits, menu.StateDifficultySelect, menu.StateAssist:
 		g.menu.Update()
 	case menu.StatePlaying:
 		if g.w != nil {
-			g.updateGame()
+			g.updateTicks()
 		}
 	}
 
 	return nil
 }
 
+// updateTicks runs as many simulation ticks as the debug time controls ask
+// for; release builds always run exactly one.
+func (g *Game) updateTicks() {
+	if w, ok := debug.Rewind(); ok {
+		g.w = w
+		return
+	}
+
+	for range g.slowTicks(debug.UpdateTimeControls()) {
+		w := g.w
+		g.updateGame()
+		debug.RecordTick(g.w)
+
+		if g.w != w || g.menu.GetState() != menu.StatePlaying || (g.editor != nil && g.editor.Active()) {
+			return
+		}
+	}
+}
+
+// slowTicks thins n ticks out by the game speed assist. Demo recordings
+// always run at full speed.
+func (g *Game) slowTicks(n int) int {
+	speed := game.CurrentAssists().GameSpeed
+	if speed >= 1 || g.recording != nil {
+		return n
+	}
+
+	ticks := 0
+	for range n {
+		g.speedClock += speed
+		if g.speedClock >= 1 {
+			g.speedClock--
+			ticks++
+		}
+	}
+	return ticks
+}
+
 func (g *Game) updateGame() {
-	systems.MoveCharacter(g.w)
-	systems.UpdateCharacterSprite(g.w)
-	systems.UpdateCannons(g.w)
-
-	cfg, _ := ecs.GetResource[physics.Config](g.w)
-
-	systems.ApplyGravity(g.w, cfg)
-	systems.ApplyAccumulated(g.w, cfg)
-	collisions := systems.ApplyVelocityWithCollisions(g.w, cfg)
-	systems.HandleProjectileCollisions(g.w, collisions)
-	systems.ApplySpikes(g.w)
-	systems.ApplyAnimation(g.w)
-	systems.ApplySlopeGravity(g.w, cfg)
-	systems.ApplyFriction(g.w, cfg)
-	systems.ApplyConveyorBelt(g.w)
-	systems.UpdateProjectileLifetime(g.w)
-	systems.CleanupOffscreenProjectiles(g.w, assets.WorldWidth, assets.WorldHeight)
-	systems.DrawLifeCounter(g.w)
+	g.speedrun.Tick()
+	input.Apply(g.w, g.input.Poll())
+	if g.recording == nil {
+		applyAssists(g.w)
+	}
+	simulate(g.w)
+	g.hud.Update(g.w)
 
 	if systems.ApplyEpilogueFinish(g.w) {
+		g.levelManager.CompleteLevel(g.w)
+		g.splitRun()
 		g.menu.ShowEpilogueEnding()
 		return
 	}
 
 	if systems.ApplyLevelFinish(g.w) {
-		g.menu.ShowLevelComplete()
+		if g.playtesting {
+			g.editor.Open()
+			return
+		}
+		g.levelManager.CompleteLevel(g.w)
+		g.splitRun()
+		g.menu.ShowLevelComplete(g.levelManager.HasNextLevel())
 		return
 	}
 
 	g.checkGameOver()
 
-	g.updateCameraTarget()
-	systems.UpdateCameraSystem(g.w)
+	followCharacter(g.w)
+}
+
+// applyAssists hands the player's assist options to the world; demos never
+// get them, so they replay the same.
+func applyAssists(w *ecs.World) {
+	a := game.CurrentAssists()
+	w.SetResource(components.Assist{
+		Invincible:    a.Invincible,
+		InfiniteLives: a.InfiniteLives,
+		AirControl:    a.AirControl,
+	})
+}
+
+// simulate runs one tick of every gameplay system; the game flow (level
+// finish, game over) is up to the caller.
+func simulate(w *ecs.World) {
+	systems.UpdateLevelStats(w)
+	systems.MoveCharacter(w)
+	systems.MoveEchoes(w)
+	systems.RecordLife(w)
+	systems.ApplySacrifice(w)
+	systems.UpdateSignals(w)
+	systems.UpdateDoors(w)
+	systems.UpdateMovingPlatforms(w)
+	systems.ApplyCannonTriggers(w)
+	systems.UpdateCannons(w)
+	systems.SteerHomingProjectiles(w)
+	systems.UpdateEnemies(w)
+	systems.UpdateSaws(w)
+	systems.UpdateCrushers(w)
+	systems.UpdateFallingBlocks(w)
+
+	cfg, _ := ecs.GetResource[physics.Config](w)
+
+	systems.ApplyGravity(w, cfg)
+	systems.ApplyAccumulated(w, cfg)
+	collisions := systems.ApplyVelocityWithCollisions(w, cfg)
+	systems.HandleProjectileCollisions(w, collisions)
+	systems.ApplySpikes(w)
+	systems.ApplyEnemyContacts(w, collisions)
+	systems.ApplyHazards(w, collisions)
+	systems.ApplyLevelBounds(w)
+	systems.ApplyCollectibles(w)
+	systems.UpdateHiddenWalls(w)
+	systems.ApplyAnimation(w)
+	systems.ApplySlopeGravity(w, cfg)
+	systems.ApplyFriction(w, cfg)
+	systems.ApplyConveyorBelt(w)
+	systems.UpdateCharacterAnimation(w)
+	systems.RecordGhost(w)
+	systems.UpdateGhosts(w)
+	systems.UpdateProjectileLifetime(w)
+	systems.RotateProjectiles(w)
+	systems.UpdateCorpseFlash(w)
+	systems.UpdateInvulnerability(w)
+	systems.SettleCorpses(w)
+	systems.UpdateFades(w)
+	systems.CleanupOffscreenProjectiles(w, assets.WorldWidth, assets.WorldHeight)
+}
+
+// importGhosts races ghost files dropped on the window from the next
+// attempt at their level.
+func (g *Game) importGhosts() {
+	files := ebiten.DroppedFiles()
+	if files == nil {
+		return
+	}
+	for _, run := range ghost.ImportFS(files) {
+		fmt.Printf("ghost: imported %s (%s)\n", run.Level, speedrun.FormatTicks(run.Ticks()))
+	}
+}
+
+// splitRun closes the speedrun segment of the level just finished and ends
+// the run after the last one.
+func (g *Game) splitRun() {
+	g.speedrun.Split(g.levelManager.Current().Name)
+	if g.levelManager.HasNextLevel() {
+		return
+	}
+	if g.speedrun.Finish() {
+		fmt.Println("speedrun: new personal best", speedrun.FormatTicks(g.speedrun.Ticks))
+	}
+}
+
+// countsMenuTime reports whether the run timer should tick in a menu screen
+// shown during a run.
+func (g *Game) countsMenuTime(state menu.GameState) bool {
+	if !game.CountsMenuTime() {
+		return false
+	}
+	switch state {
+	case menu.StatePaused, menu.StateConfirmRestart, menu.StateSettings, menu.StateAssist, menu.StateLevelComplete, menu.StateGameOver:
+		return g.w != nil
+	}
+	return false
 }
 
 func (g *Game) checkGameOver() {
@@ -137,66 +364,124 @@ func (g *Game) checkGameOver() {
 		return
 	}
 
-	lifeEntities := g.w.GetEntities(
+	if !isGameOver(g.w) {
+		return
+	}
+
+	if g.playtesting {
+		g.editor.Open()
+		return
+	}
+	g.menu.ShowGameOver(g.levelManager.Continues())
+}
+
+func isGameOver(w *ecs.World) bool {
+	lifeEntities := w.GetEntities(
 		reflect.TypeOf((*components.Life)(nil)).Elem(),
 	)
 
 	if len(lifeEntities) == 0 {
-		g.menu.ShowGameOver()
-		return
+		return true
 	}
 
-	life, err := ecs.GetComponent[components.Life](g.w, lifeEntities[0])
+	life, err := ecs.GetComponent[components.Life](w, lifeEntities[0])
 	if err != nil {
-		return
+		return false
 	}
 
-	if life.Count <= 0 {
-		g.menu.ShowGameOver()
-	}
+	return life.Count <= 0
 }
 
-func (g *Game) updateCameraTarget() {
-	camera, err := ecs.GetResource[components.Camera](g.w)
-	if err != nil {
-		return
+// updateEditor toggles the level editor and runs it while it is open. It
+// returns true when the editor consumed the frame.
+func (g *Game) updateEditor() bool {
+	if inpututil.IsKeyJustPressed(editor.ToggleKey) {
+		if g.editor.Active() {
+			g.editor.Close()
+		} else {
+			g.editor.Open()
+		}
+		return true
 	}
 
-	entities := g.w.GetEntities(
-		reflect.TypeOf((*components.Character)(nil)).Elem(),
-	)
-	if len(entities) > 0 {
-		camera.Target = int64(entities[0])
-		g.w.SetResource(*camera)
+	if !g.editor.Active() {
+		return false
+	}
+
+	if g.editor.Update() {
+		g.editor.Close()
+		g.w = g.editor.Playtest()
+		g.playtesting = true
+		g.menu.SetState(menu.StatePlaying)
 	}
+	return true
 }
 
-func (g *Game) Draw(screen *ebiten.Image) {
+// followCharacter points the camera at the character and moves it.
+func followCharacter(w *ecs.World) {
+	camera, err := ecs.GetResource[components.Camera](w)
+	if err == nil {
+		entities := w.GetEntities(
+			reflect.TypeOf((*components.Character)(nil)).Elem(),
+		)
+		if len(entities) > 0 {
+			camera.Target = int64(entities[0])
+			w.SetResource(*camera)
+		}
+	}
+
+	systems.UpdateCameraSystem(w)
+}
+
+func (g *Game) draw(screen *ebiten.Image) {
+	if g.editor != nil && g.editor.Active() {
+		g.editor.Draw(screen)
+		return
+	}
+
 	state := g.menu.GetState()
 
 	switch state {
 	case menu.StateMenu, menu.StateDifficultySelect:
+		g.drawAttract(screen)
 		g.menu.Draw(screen)
 	case menu.StatePlaying:
-		if g.w != nil {
-			camera, _ := ecs.GetResource[components.Camera](g.w)
-			systems.DrawSpritesWithCamera(g.w, screen, camera)
-			utils.DrawLoreText(g.w, screen)
-		}
-	case menu.StatePaused, menu.StateConfirmRestart, menu.StateSettings, menu.StateLevelComplete, menu.StateGameOver:
+		g.drawWorld(screen)
+	case menu.StatePaused, menu.StateConfirmRestart, menu.StateSettings, menu.StateAssist, menu.StateLevelComplete, menu.StateGameOver:
 		// Draw game underneath if exists
-		if g.w != nil {
-			camera, _ := ecs.GetResource[components.Camera](g.w)
-			systems.DrawSpritesWithCamera(g.w, screen, camera)
-			utils.DrawLoreText(g.w, screen)
-		}
+		g.drawWorld(screen)
 		// Draw menu overlay
 		g.menu.Draw(screen)
-	case menu.StateEpilogueEnding:
+	case menu.StateEpilogueEnding, menu.StateCredits:
 		g.menu.Draw(screen)
 	}
 }
 
+func (g *Game) drawWorld(screen *ebiten.Image) {
+	if g.w == nil {
+		return
+	}
+
+	camera, _ := ecs.GetResource[components.Camera](g.w)
+	systems.DrawSpritesWithCamera(g.w, screen, camera)
+	utils.DrawLoreText(g.w, screen)
+
+	g.hud.Draw(screen)
+
+	if g.showDebugOverlay {
+		systems.DrawDebugOverlay(g.w, screen, camera)
+	}
+	debug.DrawTimeControls(screen)
+}
+
+func (g *Game) Draw(screen *ebiten.Image) {
+	g.draw(screen)
+
+	if g.console != nil {
+		g.console.Draw(screen)
+	}
+}
+
 func (g *Game) Layout(outsideWidth, outsideHeight int) (screenWidth, screenHeight int) {
 	return menu.ScreenWidth, menu.ScreenHeight
 }
@@ -10,18 +10,16 @@ import (
 	"github.com/game-jam-2026/dead-jump/pkg/linalg"
 )
 
-func Load2CannonsLevel() *ecs.World {
+func Load2CannonsLevel(p game.Profile) *ecs.World {
 	w := ecs.NewWorld()
 	CreateAudioManager(w)
 	CreateLifeCounter(w, 5)
 
-	CreateTiledPlatform(w, 0, 368, 31, TileGroundTextured)
+	CreateTiledPlatform(w, 0, 368, 31, Image(ImageTileGroundTextured))
 
-	CreateTiledPlatform(w, 28, 336, 5, TileGroundGrass)
+	CreateTiledPlatform(w, 28, 336, 5, Image(ImageTileGroundGrass))
 
-	if TileTree != nil {
-		CreateDecoration(w, 16, 304, TileTree)
-	}
+	CreateDecoration(w, 16, 304, Image(ImageTileTree))
 
 	CreateStartPoint(w, 30, 320)
 	playerID := CreateCharacter(w, 30, 320, 1.0)
@@ -31,65 +29,55 @@ func Load2CannonsLevel() *ecs.World {
 		Count:     8,
 	})
 
-	CreateTiledPlatform(w, 208, 336, 4, TileGroundGrass)
-
-	cannonEntity := CreateCannon(w, 224, 304, -math.Pi*3/4, -1)
-	cannon, _ := ecs.GetComponent[components.Cannon](w, cannonEntity)
-	cannon.Active = true
-	cannon.BurstCount = 5
-	cannon.BurstDelay = 7
-	cannon.FireRate = 140
-	cannon.ProjectileSpeed = 28.5
-	cannon.ProjectileMass = 14.0
-	w.SetComponent(cannonEntity, *cannon)
-
-	CreateTiledPlatform(w, 32, 256, 5, TileGroundGrass)
-	if TileTree != nil {
-		CreateDecoration(w, 40, 224, TileTree)
-	}
+	CreateTiledPlatform(w, 208, 336, 4, Image(ImageTileGroundGrass))
+
+	burstCannon := DefaultCannonConfig()
+	burstCannon.Direction = -math.Pi * 3 / 4
+	burstCannon.Facing = -1
+	burstCannon.BurstCount = 5
+	burstCannon.BurstDelay = 7
+	burstCannon.FireRate = 140
+	burstCannon.ProjectileSpeed = 28.5
+	burstCannon.ProjectileMass = 14.0
+	CreateCannon(w, 224, 304, burstCannon)
+
+	CreateTiledPlatform(w, 32, 256, 5, Image(ImageTileGroundGrass))
+	CreateDecoration(w, 40, 224, Image(ImageTileTree))
 
-	cannonEntityMidPl := CreateCannon(w, 62, 224, -math.Pi/5, 1)
-	cannonMidPl, _ := ecs.GetComponent[components.Cannon](w, cannonEntityMidPl)
-	cannonMidPl.Active = true
-	cannonMidPl.BurstCount = 5
-	cannonMidPl.BurstDelay = 7
-	cannonMidPl.FireRate = 140
-	cannonMidPl.FramesSinceLastShot = 70
-	cannonMidPl.ProjectileSpeed = 28.5
-	cannonMidPl.ProjectileMass = 14.0
-	w.SetComponent(cannonEntityMidPl, *cannonMidPl)
+	midCannon := burstCannon
+	midCannon.Direction = -math.Pi / 5
+	midCannon.Facing = 1
+	midCannon.FirstShotDelay = 70
+	CreateCannon(w, 62, 224, midCannon)
 
-	CreateTiledPlatform(w, 224, 192, 7, TileGroundGrass)
+	CreateTiledPlatform(w, 224, 192, 7, Image(ImageTileGroundGrass))
 
 	CreateSpike(w, 304, 176, components.Repeatable{
 		Direction: linalg.Vector2{X: 1},
 		Count:     1,
 	})
 
-	CreateTiledPlatformTall(w, 368, 96, 8, 2, TileGroundTextured)
-	CreateTiledPlatform(w, 368, 80, 8, TileGroundGrass)
+	CreateFruit(w, 60, 236)
+	CreateFruit(w, 320, 150)
 
-	if TileTree != nil {
-		CreateDecoration(w, 376, 48, TileTree)
-		CreateDecoration(w, 416, 48, TileTree)
-	}
+	CreateTiledPlatformTall(w, 368, 96, 8, 2, Image(ImageTileGroundTextured))
+	CreateTiledPlatform(w, 368, 80, 8, Image(ImageTileGroundGrass))
+
+	CreateDecoration(w, 376, 48, Image(ImageTileTree))
+	CreateDecoration(w, 416, 48, Image(ImageTileTree))
 
-	if game.IsEasyMode() {
+	if p.Difficulty != game.DifficultyHard {
 		CreateLevelFinish(w, 260, 172)
 	} else {
 		CreateLevelFinish(w, 432, 55)
 	}
 
-	if TileColumn != nil {
-		for i := 0; i < 23; i++ {
-			CreateDecoration(w, 0, float64(i*16), TileColumn)
-		}
+	for i := 0; i < 23; i++ {
+		CreateDecoration(w, 0, float64(i*16), Image(ImageTileColumn))
 	}
 
-	if TileColumn != nil {
-		for i := 0; i < 23; i++ {
-			CreateDecoration(w, 484, float64(i*16), TileColumn)
-		}
+	for i := 0; i < 23; i++ {
+		CreateDecoration(w, 484, float64(i*16), Image(ImageTileColumn))
 	}
 
 	CreateGround(w, 0, 0, 8, 400, components.Repeatable{})
@@ -103,8 +91,11 @@ func Load2CannonsLevel() *ecs.World {
 	camera.DeadZoneX = 70
 	camera.DeadZoneY = 30
 	w.SetResource(camera)
+	w.SetResource(components.NewLevelBounds(500, 384))
 
 	w.SetResource(*physics.DefaultConfig())
 
+	ApplyProfile(w, p)
+
 	return w
 }
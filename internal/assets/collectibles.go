@@ -0,0 +1,134 @@
+package assets
+
+import (
+	"fmt"
+	"image/color"
+
+	"github.com/hajimehoshi/ebiten/v2"
+	"github.com/solarlune/resolv"
+
+	"github.com/game-jam-2026/dead-jump/internal/ecs"
+	"github.com/game-jam-2026/dead-jump/internal/ecs/components"
+	"github.com/game-jam-2026/dead-jump/pkg/linalg"
+)
+
+var (
+	fruitImage *ebiten.Image
+	shardImage *ebiten.Image
+)
+
+// CreateFruit creates an optional fruit pickup.
+func CreateFruit(w *ecs.World, x, y float64) ecs.EntityID {
+	return createCollectible(w, x, y, components.CollectibleFruit, "fruit", fruitSprite())
+}
+
+// CreateSoulShard creates an optional soul shard, usually hidden in a secret
+// area.
+func CreateSoulShard(w *ecs.World, x, y float64) ecs.EntityID {
+	return createCollectible(w, x, y, components.CollectibleShard, "shard", shardSprite())
+}
+
+func createCollectible(w *ecs.World, x, y float64, kind components.CollectibleKind, name string, img *ebiten.Image) ecs.EntityID {
+	entity := w.CreateEntity()
+
+	bounds := img.Bounds()
+	w.SetComponent(entity, components.Position{
+		Vector: linalg.Vector2{X: x, Y: y},
+	})
+	w.SetComponent(entity, components.Sprite{Image: img, ZIndex: 2})
+	w.SetComponent(entity, components.Collision{
+		Shape: resolv.NewRectangleFromTopLeft(x, y, float64(bounds.Dx()), float64(bounds.Dy())),
+	})
+	w.SetComponent(entity, components.Sensor{})
+	w.SetComponent(entity, components.Collectible{
+		Kind: kind,
+		ID:   fmt.Sprintf("%s@%d,%d", name, int(x), int(y)),
+	})
+
+	return entity
+}
+
+// CreateHiddenWall covers a secret area with wall tiles drawn over the
+// character; it is not solid and fades once the character walks in.
+func CreateHiddenWall(w *ecs.World, x, y float64, tilesWide, tilesHigh int, tile *ebiten.Image) ecs.EntityID {
+	entity := w.CreateEntity()
+
+	width := float64(tilesWide * TileSize)
+	height := float64(tilesHigh * TileSize)
+
+	img := ebiten.NewImage(int(width), int(height))
+	for j := 0; j < tilesHigh; j++ {
+		for i := 0; i < tilesWide; i++ {
+			op := &ebiten.DrawImageOptions{}
+			op.GeoM.Translate(float64(i*TileSize), float64(j*TileSize))
+			img.DrawImage(tile, op)
+		}
+	}
+
+	w.SetComponent(entity, components.Position{
+		Vector: linalg.Vector2{X: x, Y: y},
+	})
+	w.SetComponent(entity, components.Sprite{Image: img, ZIndex: 10})
+	w.SetComponent(entity, components.Collision{
+		Shape: resolv.NewRectangleFromTopLeft(x, y, width, height),
+	})
+	w.SetComponent(entity, components.Sensor{})
+	w.SetComponent(entity, components.HiddenWall{})
+
+	return entity
+}
+
+func fruitSprite() *ebiten.Image {
+	if fruitImage != nil {
+		return fruitImage
+	}
+
+	rows := []string{
+		"....g...",
+		"...g....",
+		".rrrrr..",
+		"rrwrrrr.",
+		"rwrrrrr.",
+		"rrrrrrr.",
+		".rrrrd..",
+		"..ddd...",
+	}
+	fruitImage = pixelImage(rows, map[byte]color.RGBA{
+		'g': {80, 160, 60, 255},
+		'r': {210, 50, 60, 255},
+		'w': {255, 200, 200, 255},
+		'd': {150, 30, 40, 255},
+	})
+	return fruitImage
+}
+
+func shardSprite() *ebiten.Image {
+	if shardImage != nil {
+		return shardImage
+	}
+
+	rows := []string{
+		"...w...",
+		"..wcc..",
+		"..ccc..",
+		".wcccb.",
+		".ccccb.",
+		"..ccb..",
+		"..cbb..",
+		"...b...",
+	}
+	shardImage = pixelImage(rows, map[byte]color.RGBA{
+		'w': {230, 250, 255, 255},
+		'c': {120, 200, 240, 255},
+		'b': {60, 110, 180, 255},
+	})
+	return shardImage
+}
+
+// CollectibleImage returns the sprite of the collectible kind, for the HUD.
+func CollectibleImage(kind components.CollectibleKind) *ebiten.Image {
+	if kind == components.CollectibleShard {
+		return shardSprite()
+	}
+	return fruitSprite()
+}
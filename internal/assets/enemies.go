@@ -0,0 +1,175 @@
+package assets
+
+import (
+	"image/color"
+
+	"github.com/hajimehoshi/ebiten/v2"
+	"github.com/solarlune/resolv"
+
+	"github.com/game-jam-2026/dead-jump/internal/ecs"
+	"github.com/game-jam-2026/dead-jump/internal/ecs/components"
+	"github.com/game-jam-2026/dead-jump/pkg/linalg"
+)
+
+const enemyStompBounce = 4.5
+
+var (
+	crawlerImage *ebiten.Image
+	batImage     *ebiten.Image
+)
+
+// CreateEnemy creates a bare enemy running the given machine; the behavior
+// settings (Patrol, Walker, SineMover, Chaser) are up to the caller.
+func CreateEnemy(w *ecs.World, x, y float64, machine string, flying bool) ecs.EntityID {
+	entity := w.CreateEntity()
+
+	img := enemyCrawlerImage()
+	if flying {
+		img = enemyBatImage()
+	}
+	bounds := img.Bounds()
+
+	w.SetComponent(entity, components.Position{
+		Vector: linalg.Vector2{X: x, Y: y},
+	})
+	w.SetComponent(entity, components.Sprite{Image: img, ZIndex: 2})
+	w.SetComponent(entity, components.Collision{
+		Shape: resolv.NewRectangleFromTopLeft(x, y, float64(bounds.Dx()), float64(bounds.Dy())),
+	})
+	w.SetComponent(entity, components.Velocity{Vector: linalg.Zero()})
+
+	body := components.DefaultPhysicsBody()
+	body.Mass = 2
+	body.Friction = 0
+	body.AirDrag = 0
+	body.MaxSpeed = 6
+	if flying {
+		body.GravityScale = 0
+	}
+	w.SetComponent(entity, body)
+
+	w.SetComponent(entity, components.Enemy{
+		Stompable:   true,
+		StompBounce: enemyStompBounce,
+	})
+	w.SetComponent(entity, components.Brain{Machine: machine, Facing: 1})
+
+	return entity
+}
+
+// CreateWalker walks along a platform and turns at walls and ledges.
+func CreateWalker(w *ecs.World, x, y float64) ecs.EntityID {
+	entity := CreateEnemy(w, x, y, components.MachineWalker, false)
+	w.SetComponent(entity, components.Walker{Speed: 0.6})
+	return entity
+}
+
+// CreatePatroller walks between the given points; the enemy center is
+// what reaches them.
+func CreatePatroller(w *ecs.World, x, y float64, points ...linalg.Vector2) ecs.EntityID {
+	entity := CreateEnemy(w, x, y, components.MachinePatrol, false)
+	w.SetComponent(entity, components.Patrol{Points: points, Speed: 0.6})
+	return entity
+}
+
+// CreateGuard patrols between x and toX and chases the character on sight.
+func CreateGuard(w *ecs.World, x, y, toX float64) ecs.EntityID {
+	entity := CreateEnemy(w, x, y, components.MachineGuard, false)
+	center := enemyCenter(w, entity)
+	w.SetComponent(entity, components.Patrol{
+		Points: []linalg.Vector2{center, {X: center.X + toX - x, Y: center.Y}},
+		Speed:  0.5,
+	})
+	w.SetComponent(entity, components.Chaser{Speed: 1.2, SightRange: 96, GiveUpTicks: 90})
+	return entity
+}
+
+// CreateFlyer flies back and forth around its spawn point.
+func CreateFlyer(w *ecs.World, x, y float64) ecs.EntityID {
+	entity := CreateEnemy(w, x, y, components.MachineFlyer, true)
+	w.SetComponent(entity, flyerMover(enemyCenter(w, entity)))
+	return entity
+}
+
+// CreateBat is a flyer that swoops at the character on sight.
+func CreateBat(w *ecs.World, x, y float64) ecs.EntityID {
+	entity := CreateEnemy(w, x, y, components.MachineBat, true)
+	w.SetComponent(entity, flyerMover(enemyCenter(w, entity)))
+	w.SetComponent(entity, components.Chaser{Speed: 1.0, SightRange: 80, GiveUpTicks: 60})
+	return entity
+}
+
+func flyerMover(origin linalg.Vector2) components.SineMover {
+	return components.SineMover{
+		Origin:    origin,
+		Range:     48,
+		Speed:     0.7,
+		Amplitude: 10,
+		Period:    90,
+	}
+}
+
+func enemyCenter(w *ecs.World, entity ecs.EntityID) linalg.Vector2 {
+	pos, _ := ecs.GetComponent[components.Position](w, entity)
+	col, _ := ecs.GetComponent[components.Collision](w, entity)
+	bounds := col.Shape.Bounds()
+	return pos.Vector.Add(linalg.Vector2{X: bounds.Width() / 2, Y: bounds.Height() / 2})
+}
+
+func enemyCrawlerImage() *ebiten.Image {
+	if crawlerImage != nil {
+		return crawlerImage
+	}
+
+	rows := []string{
+		"....####....",
+		"..########..",
+		".##########.",
+		".####w#w###.",
+		"#####w#w####",
+		"############",
+		"############",
+		".##########.",
+		".#.#.##.#.#.",
+		"#..#....#..#",
+	}
+	crawlerImage = pixelImage(rows, map[byte]color.RGBA{
+		'#': {90, 40, 110, 255},
+		'w': {240, 230, 120, 255},
+	})
+	return crawlerImage
+}
+
+func enemyBatImage() *ebiten.Image {
+	if batImage != nil {
+		return batImage
+	}
+
+	rows := []string{
+		"#....##....#",
+		"##..####..##",
+		"###.#w#w.###",
+		"############",
+		".###.##.###.",
+		"..#..##..#..",
+		"......#.....",
+	}
+	batImage = pixelImage(rows, map[byte]color.RGBA{
+		'#': {40, 30, 50, 255},
+		'w': {230, 60, 60, 255},
+	})
+	return batImage
+}
+
+// pixelImage draws ASCII art; '.' and unknown characters stay transparent.
+func pixelImage(rows []string, palette map[byte]color.RGBA) *ebiten.Image {
+	img := ebiten.NewImage(len(rows[0]), len(rows))
+	for y, row := range rows {
+		for x := 0; x < len(row); x++ {
+			if c, ok := palette[row[x]]; ok {
+				img.Set(x, y, c)
+			}
+		}
+	}
+	return img
+}
@@ -1,11 +1,8 @@
 package assets
 
 import (
-	"bytes"
-	_ "embed"
 	"fmt"
 	"image"
-	_ "image/png"
 	"math"
 
 	"github.com/hajimehoshi/ebiten/v2"
@@ -17,148 +14,32 @@ import (
 	"github.com/game-jam-2026/dead-jump/pkg/linalg"
 )
 
-var (
-	//go:embed img/hero.png
-	HeroPNG []byte
-	//go:embed img/hero_jump.png
-	HeroJumpPNG []byte
-	//go:embed img/dead_hero.png
-	DeadHeroPNG []byte
-	//go:embed img/spike.png
-	SpikePNG []byte
-	//go:embed img/ground.png
-	GroundPNG []byte
-	//go:embed img/orange.png
-	OrangePNG []byte
-	//go:embed img/wall_left.png
-	WallLeftPNG []byte
-	//go:embed img/wall_right.png
-	WallRightPNG []byte
-	//go:embed img/fir_right.png
-	FirRightPNG []byte
-	//go:embed img/fir_left.png
-	FirLeftPNG []byte
-	//go:embed img/moon.png
-	MoonPNG []byte
-	//go:embed img/tombstone1.png
-	Tombstone1PNG []byte
-	//go:embed img/tombstone2.png
-	Tombstone2PNG []byte
-	//go:embed img/tombstone3.png
-	Tombstone3PNG []byte
-	//go:embed img/cannon_left.png
-	CannonLeftPNG []byte
-	//go:embed img/cannon_top.png
-	CannonTopPNG []byte
-	//go:embed img/cannon_right.png
-	CannonRightPNG []byte
-)
-
-var (
-	HeroImage        *ebiten.Image
-	HeroJumpImage    *ebiten.Image
-	DeadHeroImage    *ebiten.Image
-	SpikeImage       *ebiten.Image
-	GroundImage      *ebiten.Image
-	OrangeImage      *ebiten.Image
-	Tombstone1Image  *ebiten.Image
-	Tombstone2Image  *ebiten.Image
-	Tombstone3Image  *ebiten.Image
-	WallLeftImage    *ebiten.Image
-	WallRightImage   *ebiten.Image
-	FirRightImage    *ebiten.Image
-	FirLeftImage     *ebiten.Image
-	MoonImage        *ebiten.Image
-	CannonLeftImage  *ebiten.Image
-	CannonTopImage   *ebiten.Image
-	CannonRightImage *ebiten.Image
-)
-
-func init() {
-	heroImg, _, _ := image.Decode(bytes.NewReader(HeroPNG))
-	HeroImage = ebiten.NewImageFromImage(heroImg)
-
-	heroJumpImg, _, _ := image.Decode(bytes.NewReader(HeroJumpPNG))
-	HeroJumpImage = ebiten.NewImageFromImage(heroJumpImg)
-
-	deadHeroImg, _, _ := image.Decode(bytes.NewReader(DeadHeroPNG))
-	DeadHeroImage = ebiten.NewImageFromImage(deadHeroImg)
-
-	spikeImg, _, _ := image.Decode(bytes.NewReader(SpikePNG))
-	SpikeImage = ebiten.NewImageFromImage(spikeImg)
-
-	groundImg, _, _ := image.Decode(bytes.NewReader(GroundPNG))
-	GroundImage = ebiten.NewImageFromImage(groundImg)
-
-	orangeImg, _, _ := image.Decode(bytes.NewReader(OrangePNG))
-	OrangeImage = ebiten.NewImageFromImage(orangeImg)
-
-	wallLeftImg, _, _ := image.Decode(bytes.NewReader(WallLeftPNG))
-	WallLeftImage = ebiten.NewImageFromImage(wallLeftImg)
-
-	wallRightImg, _, _ := image.Decode(bytes.NewReader(WallRightPNG))
-	WallRightImage = ebiten.NewImageFromImage(wallRightImg)
-
-	firRightImg, _, _ := image.Decode(bytes.NewReader(FirRightPNG))
-	FirRightImage = ebiten.NewImageFromImage(firRightImg)
-
-	firLeftImg, _, _ := image.Decode(bytes.NewReader(FirLeftPNG))
-	FirLeftImage = ebiten.NewImageFromImage(firLeftImg)
-
-	moonImg, _, _ := image.Decode(bytes.NewReader(MoonPNG))
-	MoonImage = ebiten.NewImageFromImage(moonImg)
-
-	tombstone1Img, _, _ := image.Decode(bytes.NewReader(Tombstone1PNG))
-	Tombstone1Image = ebiten.NewImageFromImage(tombstone1Img)
-
-	tombstone2Img, _, _ := image.Decode(bytes.NewReader(Tombstone2PNG))
-	Tombstone2Image = ebiten.NewImageFromImage(tombstone2Img)
-
-	tombstone3Img, _, _ := image.Decode(bytes.NewReader(Tombstone3PNG))
-	Tombstone3Image = ebiten.NewImageFromImage(tombstone3Img)
-
-	cannonLeftImg, _, _ := image.Decode(bytes.NewReader(CannonLeftPNG))
-	CannonLeftImage = ebiten.NewImageFromImage(cannonLeftImg)
-
-	cannonTopImg, _, _ := image.Decode(bytes.NewReader(CannonTopPNG))
-	CannonTopImage = ebiten.NewImageFromImage(cannonTopImg)
+func CreateCharacter(w *ecs.World, x, y float64, scale float64) ecs.EntityID {
+	entity := createHero(w, x, y, scale)
+	w.SetComponent(entity, components.Character{})
 
-	cannonRightImg, _, _ := image.Decode(bytes.NewReader(CannonRightPNG))
-	CannonRightImage = ebiten.NewImageFromImage(cannonRightImg)
+	return entity
 }
 
-func CreateCharacter(w *ecs.World, x, y float64, scale float64) ecs.EntityID {
+// createHero creates the hero's body, moved by whatever drives it.
+func createHero(w *ecs.World, x, y float64, scale float64) ecs.EntityID {
 	entity := w.CreateEntity()
 
-	bounds := HeroImage.Bounds()
-	origW := float64(bounds.Dx())
-	origH := float64(bounds.Dy())
-	width := origW * scale
-	height := origH * scale
-
-	groundedSprite := ebiten.NewImage(int(width), int(height))
-	op := &ebiten.DrawImageOptions{}
-	op.GeoM.Scale(scale, scale)
-	groundedSprite.DrawImage(HeroImage, op)
-
-	// jumping sprite
-	jumpBounds := HeroJumpImage.Bounds()
-	jumpOrigW := float64(jumpBounds.Dx())
-	jumpOrigH := float64(jumpBounds.Dy())
-	jumpWidth := jumpOrigW * scale
-	jumpHeight := jumpOrigH * scale
-
-	jumpingSprite := ebiten.NewImage(int(jumpWidth), int(jumpHeight))
-	opJump := &ebiten.DrawImageOptions{}
-	opJump.GeoM.Scale(scale, scale)
-	jumpingSprite.DrawImage(HeroJumpImage, opJump)
+	bounds := Image(ImageHero).Bounds()
+	width := float64(bounds.Dx()) * scale
+	height := float64(bounds.Dy()) * scale
+
+	animator := NewHeroAnimator()
+
+	sprite := components.Sprite{
+		Image: animator.CurrentFrame(),
+	}
+	sprite.SetUniformScale(scale)
 
 	w.SetComponent(entity, components.Position{
 		Vector: linalg.Vector2{X: x, Y: y},
 	})
-	w.SetComponent(entity, components.Sprite{
-		Image: groundedSprite,
-	})
+	w.SetComponent(entity, sprite)
 	w.SetComponent(entity, components.Collision{
 		Shape: resolv.NewRectangleFromTopLeft(x, y, width, height),
 	})
@@ -174,38 +55,35 @@ func CreateCharacter(w *ecs.World, x, y float64, scale float64) ecs.EntityID {
 	body.MaxSpeed = 20.0
 	w.SetComponent(entity, body)
 
-	w.SetComponent(entity, components.Character{
-		GroundedSprite: groundedSprite,
-		JumpingSprite:  jumpingSprite,
-	})
+	w.SetComponent(entity, animator)
 
 	return entity
 }
 
+// CreateLifeCounter creates the entity holding the level's lives; the HUD
+// draws it.
 func CreateLifeCounter(w *ecs.World, lifeCnt int) ecs.EntityID {
 	entity := w.CreateEntity()
-	w.SetComponent(entity, components.Position{
-		Vector: linalg.Vector2{X: 10, Y: 10},
-	})
-	w.SetComponent(entity, components.Sprite{
-		Image: OrangeImage,
-	})
 	w.SetComponent(entity, components.Life{
 		Count: lifeCnt,
 	})
-	w.SetComponent(entity, components.Repeatable{
-		Direction: linalg.Vector2{X: 1},
-		Count:     lifeCnt,
-	})
-	w.SetComponent(entity, components.ScreenSpace{})
 
 	return entity
 }
 
+// SetSacrifices limits the sacrifice action in the level: zero leaves it
+// unlimited and a negative limit disables it.
+func SetSacrifices(w *ecs.World, limit int) {
+	w.SetResource(components.Sacrifices{
+		Disabled: limit < 0,
+		Limit:    max(0, limit),
+	})
+}
+
 func CreateSpike(w *ecs.World, x, y float64, repeat components.Repeatable) ecs.EntityID {
 	entity := w.CreateEntity()
 
-	bounds := SpikeImage.Bounds()
+	bounds := Image(ImageSpike).Bounds()
 	width := float64(bounds.Dx())
 	height := float64(bounds.Dy())
 
@@ -213,7 +91,7 @@ func CreateSpike(w *ecs.World, x, y float64, repeat components.Repeatable) ecs.E
 		Vector: linalg.Vector2{X: x, Y: y},
 	})
 	w.SetComponent(entity, components.Sprite{
-		Image: SpikeImage,
+		Image: Image(ImageSpike),
 	})
 	w.SetComponent(entity, components.Collision{
 		Shape: resolv.NewRectangleFromTopLeft(x, y, width, height),
@@ -258,7 +136,7 @@ func CreateGround(w *ecs.World, x, y, width, height float64, repeatable componen
 		Vector: linalg.Vector2{X: x, Y: y},
 	})
 	w.SetComponent(entity, components.Sprite{
-		Image: GroundImage,
+		Image: Image(ImageGround),
 	})
 	w.SetComponent(entity, components.Collision{
 		Shape: resolv.NewRectangleFromTopLeft(x, y+16, width, height),
@@ -274,11 +152,8 @@ func CreateGround(w *ecs.World, x, y, width, height float64, repeatable componen
 func CreatePlatform(w *ecs.World, x, y, width, height float64, repeatable components.Repeatable) ecs.EntityID {
 	entity := w.CreateEntity()
 
-	bounds := GroundImage.Bounds()
-	halfHeight := bounds.Dy() / 2
-	halfSprite := ebiten.NewImage(bounds.Dx(), halfHeight)
-	op := &ebiten.DrawImageOptions{}
-	halfSprite.DrawImage(GroundImage.SubImage(image.Rect(0, 0, bounds.Dx(), halfHeight)).(*ebiten.Image), op)
+	bounds := Image(ImageGround).Bounds()
+	halfSprite := Image(ImageGround).SubImage(image.Rect(0, 0, bounds.Dx(), bounds.Dy()/2)).(*ebiten.Image)
 
 	w.SetComponent(entity, components.Position{
 		Vector: linalg.Vector2{X: x, Y: y},
@@ -303,7 +178,7 @@ func CreateTombstone1(w *ecs.World, x, y float64) ecs.EntityID {
 		Vector: linalg.Vector2{X: x, Y: y},
 	})
 	w.SetComponent(entity, components.Sprite{
-		Image: Tombstone1Image,
+		Image: Image(ImageTombstone1),
 	})
 	return entity
 }
@@ -314,7 +189,7 @@ func CreateTombstone2(w *ecs.World, x, y float64) ecs.EntityID {
 		Vector: linalg.Vector2{X: x, Y: y},
 	})
 	w.SetComponent(entity, components.Sprite{
-		Image: Tombstone2Image,
+		Image: Image(ImageTombstone2),
 	})
 	return entity
 }
@@ -325,7 +200,7 @@ func CreateTombstone3(w *ecs.World, x, y float64) ecs.EntityID {
 		Vector: linalg.Vector2{X: x, Y: y},
 	})
 	w.SetComponent(entity, components.Sprite{
-		Image: Tombstone3Image,
+		Image: Image(ImageTombstone3),
 	})
 	return entity
 }
@@ -378,13 +253,58 @@ func ApplyRepeatable(w *ecs.World, entity ecs.EntityID) {
 		newImg.DrawImage(sprite.Image, op)
 	}
 
-	w.SetComponent(entity, components.Sprite{Image: newImg})
+	sprite.Image = newImg
+	w.SetComponent(entity, *sprite)
 	w.SetComponent(entity, components.Collision{
 		Shape: resolv.NewRectangleFromTopLeft(pos.Vector.X, pos.Vector.Y, float64(newW), float64(newH)),
 	})
 }
 
-func CreateCannon(w *ecs.World, x, y float64, direction float64, position int) ecs.EntityID {
+// CannonConfig describes a cannon for CreateCannon. Facing picks the sprite:
+// -1 left, 0 top, 1 right.
+type CannonConfig struct {
+	Direction       float64
+	Facing          int
+	Active          bool
+	FireRate        int
+	FirstShotDelay  int
+	ProjectileSpeed float64
+	ProjectileMass  float64
+	// ProjectileType names a systems projectile type, empty fires plain shells
+	ProjectileType string
+	BurstCount     int
+	BurstDelay     int
+	SpreadCount    int
+	SpreadAngle    float64
+	TelegraphTicks int
+
+	Mode       components.CannonMode
+	TurnRate   float64
+	SweepFrom  float64
+	SweepTo    float64
+	SweepSpeed float64
+	Timeline   []components.CannonShot
+}
+
+func DefaultCannonConfig() CannonConfig {
+	cannon := components.DefaultCannon()
+
+	return CannonConfig{
+		Direction:       cannon.Direction,
+		Active:          true,
+		FireRate:        120,
+		ProjectileSpeed: 15.0,
+		ProjectileMass:  15.0,
+		BurstCount:      cannon.BurstCount,
+		BurstDelay:      cannon.BurstDelay,
+		SpreadCount:     1,
+		TelegraphTicks:  cannon.TelegraphTicks,
+		TurnRate:        0.03,
+		SweepSpeed:      0.02,
+	}
+}
+
+func CreateCannon(w *ecs.World, x, y float64, cfg CannonConfig) ecs.EntityID {
 	entity := w.CreateEntity()
 
 	w.SetComponent(entity, components.Position{
@@ -393,12 +313,12 @@ func CreateCannon(w *ecs.World, x, y float64, direction float64, position int) e
 
 	var img *ebiten.Image
 	switch {
-	case position < 0:
-		img = CannonLeftImage
-	case position > 0:
-		img = CannonRightImage
+	case cfg.Facing < 0:
+		img = Image(ImageCannonLeft)
+	case cfg.Facing > 0:
+		img = Image(ImageCannonRight)
 	default:
-		img = CannonTopImage
+		img = Image(ImageCannonTop)
 	}
 
 	w.SetComponent(entity, components.Sprite{
@@ -411,34 +331,72 @@ func CreateCannon(w *ecs.World, x, y float64, direction float64, position int) e
 	w.SetComponent(entity, components.StaticBody())
 
 	cannon := components.DefaultCannon()
-	cannon.Direction = direction
-	cannon.FireRate = 120
-	cannon.ProjectileSpeed = 15.0
-	cannon.ProjectileMass = 15.0
+	cannon.Direction = cfg.Direction
+	cannon.Active = cfg.Active
+	cannon.FireRate = cfg.FireRate
+	cannon.FramesSinceLastShot = cfg.FirstShotDelay
+	cannon.ProjectileSpeed = cfg.ProjectileSpeed
+	cannon.ProjectileMass = cfg.ProjectileMass
+	cannon.ProjectileType = cfg.ProjectileType
+	cannon.BurstCount = cfg.BurstCount
+	cannon.BurstDelay = cfg.BurstDelay
+	cannon.SpreadCount = cfg.SpreadCount
+	cannon.SpreadAngle = cfg.SpreadAngle
+	cannon.TelegraphTicks = cfg.TelegraphTicks
+	cannon.Mode = cfg.Mode
+	cannon.TurnRate = cfg.TurnRate
+	cannon.SweepFrom = cfg.SweepFrom
+	cannon.SweepTo = cfg.SweepTo
+	cannon.SweepSpeed = cfg.SweepSpeed
+	cannon.Timeline = cfg.Timeline
 	w.SetComponent(entity, cannon)
 
 	return entity
 }
 
+// CreateCannonTrigger switches the target cannons when the player walks into
+// the given area.
+func CreateCannonTrigger(w *ecs.World, x, y, width, height float64, action components.TriggerAction, targets ...ecs.EntityID) ecs.EntityID {
+	entity := w.CreateEntity()
+
+	ids := make([]int64, len(targets))
+	for i, t := range targets {
+		ids[i] = int64(t)
+	}
+
+	w.SetComponent(entity, components.Position{
+		Vector: linalg.Vector2{X: x, Y: y},
+	})
+	w.SetComponent(entity, components.Collision{
+		Shape: resolv.NewRectangleFromTopLeft(x, y, width, height),
+	})
+	w.SetComponent(entity, components.Sensor{})
+	w.SetComponent(entity, components.CannonTrigger{
+		Targets: ids,
+		Action:  action,
+		Once:    true,
+	})
+
+	return entity
+}
+
 func CreateLevelFinish(w *ecs.World, x, y float64) ecs.EntityID {
 	entity := w.CreateEntity()
 
-	bounds := OrangeImage.Bounds()
+	bounds := Image(ImageOrange).Bounds()
 	width := float64(bounds.Dx())
 	height := float64(bounds.Dy())
 
-	tintedImg := ebiten.NewImage(int(width), int(height))
-	op := &ebiten.DrawImageOptions{}
-	op.ColorScale.Scale(0.3, 0.5, 1.5, 1.0)
-	tintedImg.DrawImage(OrangeImage, op)
+	sprite := components.Sprite{
+		Image:  Image(ImageOrange),
+		ZIndex: 5,
+	}
+	sprite.ColorScale.Scale(0.3, 0.5, 1.5, 1.0)
 
 	w.SetComponent(entity, components.Position{
 		Vector: linalg.Vector2{X: x, Y: y},
 	})
-	w.SetComponent(entity, components.Sprite{
-		Image:  tintedImg,
-		ZIndex: 5,
-	})
+	w.SetComponent(entity, sprite)
 	w.SetComponent(entity, components.Collision{
 		Shape: resolv.NewRectangleFromTopLeft(x, y, width, height),
 	})
@@ -450,21 +408,15 @@ func CreateLevelFinish(w *ecs.World, x, y float64) ecs.EntityID {
 func CreateCorpse(w *ecs.World, x, y float64, scale float64) ecs.EntityID {
 	entity := w.CreateEntity()
 
-	bounds := DeadHeroImage.Bounds()
-	width := float64(bounds.Dx()) * scale
-	height := float64(bounds.Dy()) * scale
-
-	scaledImg := ebiten.NewImage(int(width), int(height))
-	op := &ebiten.DrawImageOptions{}
-	op.GeoM.Scale(scale, scale)
-	scaledImg.DrawImage(DeadHeroImage, op)
+	sprite := components.Sprite{
+		Image: Image(ImageDeadHero),
+	}
+	sprite.SetUniformScale(scale)
 
 	w.SetComponent(entity, components.Position{
 		Vector: linalg.Vector2{X: x, Y: y},
 	})
-	w.SetComponent(entity, components.Sprite{
-		Image: scaledImg,
-	})
+	w.SetComponent(entity, sprite)
 	w.SetComponent(entity, components.Corpse{
 		Durability: -1,
 		IsSettled:  true,
@@ -477,22 +429,20 @@ func CreateCorpse(w *ecs.World, x, y float64, scale float64) ecs.EntityID {
 func CreateEpilogueFinish(w *ecs.World, x, y float64) ecs.EntityID {
 	entity := w.CreateEntity()
 
-	bounds := OrangeImage.Bounds()
+	bounds := Image(ImageOrange).Bounds()
 	width := float64(bounds.Dx())
 	height := float64(bounds.Dy())
 
-	tintedImg := ebiten.NewImage(int(width), int(height))
-	op := &ebiten.DrawImageOptions{}
-	op.ColorScale.Scale(1.5, 1.0, 0.3, 1.0)
-	tintedImg.DrawImage(OrangeImage, op)
+	sprite := components.Sprite{
+		Image:  Image(ImageOrange),
+		ZIndex: 5,
+	}
+	sprite.ColorScale.Scale(1.5, 1.0, 0.3, 1.0)
 
 	w.SetComponent(entity, components.Position{
 		Vector: linalg.Vector2{X: x, Y: y},
 	})
-	w.SetComponent(entity, components.Sprite{
-		Image:  tintedImg,
-		ZIndex: 5,
-	})
+	w.SetComponent(entity, sprite)
 	w.SetComponent(entity, components.Collision{
 		Shape: resolv.NewRectangleFromTopLeft(x, y, width, height),
 	})
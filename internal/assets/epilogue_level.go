@@ -8,16 +8,18 @@ import (
 
 	"github.com/game-jam-2026/dead-jump/internal/ecs"
 	"github.com/game-jam-2026/dead-jump/internal/ecs/components"
+	"github.com/game-jam-2026/dead-jump/internal/game"
 	"github.com/game-jam-2026/dead-jump/internal/physics"
 	"github.com/game-jam-2026/dead-jump/pkg/linalg"
 )
 
 const epilogueLoreText = "You remember this place... Need one more fruit to break the cycle."
 
-func LoadEpilogueLevel() *ecs.World {
+func LoadEpilogueLevel(p game.Profile) *ecs.World {
 	w := ecs.NewWorld()
 	CreateAudioManager(w)
 	CreateLifeCounter(w, 1)
+	SetSacrifices(w, -1)
 
 	levelWidth := 320.0
 	levelHeight := 240.0
@@ -68,9 +70,12 @@ func LoadEpilogueLevel() *ecs.World {
 	camera.MaxX = 320
 	camera.MaxY = 240
 	w.SetResource(camera)
+	w.SetResource(components.NewLevelBounds(levelWidth, levelHeight))
 
 	w.SetResource(*physics.DefaultConfig())
 
+	ApplyProfile(w, p)
+
 	return w
 }
 
@@ -0,0 +1,232 @@
+package assets
+
+import (
+	"image/color"
+	"math"
+
+	"github.com/hajimehoshi/ebiten/v2"
+	"github.com/solarlune/resolv"
+
+	"github.com/game-jam-2026/dead-jump/internal/ecs"
+	"github.com/game-jam-2026/dead-jump/internal/ecs/components"
+	"github.com/game-jam-2026/dead-jump/pkg/linalg"
+)
+
+const (
+	sawSize          = 16
+	fallingBlockSize = 32
+	crusherWidth     = 32
+	crusherHeight    = 24
+)
+
+var (
+	sawImage          *ebiten.Image
+	fallingBlockImage *ebiten.Image
+	crusherImage      *ebiten.Image
+)
+
+// CreateSaw creates a spinning saw blade; with path points (blade centers)
+// it travels along them and back to the first one.
+func CreateSaw(w *ecs.World, x, y float64, path ...linalg.Vector2) ecs.EntityID {
+	entity := w.CreateEntity()
+
+	img := sawBladeImage()
+	sprite := components.Sprite{Image: img, ZIndex: 3}
+	sprite.CenterOrigin()
+
+	w.SetComponent(entity, components.Position{
+		Vector: linalg.Vector2{X: x, Y: y},
+	})
+	w.SetComponent(entity, sprite)
+	w.SetComponent(entity, components.Collision{
+		Shape: resolv.NewCircle(x+sawSize/2, y+sawSize/2, sawSize/2-1),
+	})
+	w.SetComponent(entity, components.StaticBody())
+	w.SetComponent(entity, components.Sensor{})
+	w.SetComponent(entity, components.Hazard{})
+	w.SetComponent(entity, components.Saw{Spin: 0.25})
+
+	if len(path) > 0 {
+		w.SetComponent(entity, components.Patrol{Points: path, Speed: 1})
+	}
+
+	return entity
+}
+
+// CreateFallingBlock creates a block that drops on a character walking
+// underneath and stays where it lands.
+func CreateFallingBlock(w *ecs.World, x, y float64) ecs.EntityID {
+	entity := w.CreateEntity()
+
+	w.SetComponent(entity, components.Position{
+		Vector: linalg.Vector2{X: x, Y: y},
+	})
+	w.SetComponent(entity, components.Sprite{Image: blockImage()})
+	w.SetComponent(entity, components.Collision{
+		Shape: resolv.NewRectangleFromTopLeft(x, y, fallingBlockSize, fallingBlockSize/2),
+	})
+	w.SetComponent(entity, components.StaticBody())
+	w.SetComponent(entity, components.Hazard{
+		Cause:    components.DeathCrush,
+		Side:     components.HazardSideBelow,
+		Disarmed: true,
+	})
+	w.SetComponent(entity, components.FallingBlock{
+		ShakeTicks: 30,
+		Origin:     linalg.Vector2{X: x, Y: y},
+		Reach:      160,
+	})
+
+	return entity
+}
+
+// CreateLava creates a pool the character sinks into without leaving a
+// corpse; corpses that touch it dissolve.
+func CreateLava(w *ecs.World, x, y, width, height float64) ecs.EntityID {
+	return createPool(w, x, y, width, height, color.RGBA{230, 80, 20, 255}, color.RGBA{255, 200, 60, 255})
+}
+
+// CreateAcid is the green variant of lava.
+func CreateAcid(w *ecs.World, x, y, width, height float64) ecs.EntityID {
+	return createPool(w, x, y, width, height, color.RGBA{70, 170, 40, 255}, color.RGBA{190, 250, 110, 255})
+}
+
+func createPool(w *ecs.World, x, y, width, height float64, body, surface color.RGBA) ecs.EntityID {
+	entity := w.CreateEntity()
+
+	w.SetComponent(entity, components.Position{
+		Vector: linalg.Vector2{X: x, Y: y},
+	})
+	w.SetComponent(entity, components.Sprite{
+		Image:  poolImage(int(width), int(height), body, surface),
+		ZIndex: 4,
+	})
+	w.SetComponent(entity, components.Collision{
+		Shape: resolv.NewRectangleFromTopLeft(x, y, width, height),
+	})
+	w.SetComponent(entity, components.StaticBody())
+	w.SetComponent(entity, components.Sensor{})
+	w.SetComponent(entity, components.Hazard{
+		Cause:           components.DeathLava,
+		DissolveCorpses: true,
+	})
+
+	return entity
+}
+
+// CreateCrusher creates a crusher slamming from top down to bottom (the Y of
+// its top edge) every cycle.
+func CreateCrusher(w *ecs.World, x, top, bottom float64) ecs.EntityID {
+	entity := w.CreateEntity()
+
+	w.SetComponent(entity, components.Position{
+		Vector: linalg.Vector2{X: x, Y: top},
+	})
+	w.SetComponent(entity, components.Sprite{Image: crusherBlockImage(), ZIndex: 3})
+	w.SetComponent(entity, components.Collision{
+		Shape: resolv.NewRectangleFromTopLeft(x, top, crusherWidth, crusherHeight),
+	})
+	w.SetComponent(entity, components.StaticBody())
+	w.SetComponent(entity, components.Hazard{
+		Cause:    components.DeathCrush,
+		Side:     components.HazardSideBelow,
+		Disarmed: true,
+	})
+	w.SetComponent(entity, components.Crusher{
+		Top:       top,
+		Bottom:    bottom,
+		DownSpeed: 5,
+		UpSpeed:   0.75,
+		WaitTicks: 60,
+	})
+
+	return entity
+}
+
+func sawBladeImage() *ebiten.Image {
+	if sawImage != nil {
+		return sawImage
+	}
+
+	img := ebiten.NewImage(sawSize, sawSize)
+	r := float64(sawSize) / 2
+	for i := 0; i < sawSize; i++ {
+		for j := 0; j < sawSize; j++ {
+			dx := float64(i) + 0.5 - r
+			dy := float64(j) + 0.5 - r
+			d := math.Sqrt(dx*dx + dy*dy)
+			// зубья: радиус скачет по углу
+			teeth := r - 1.5 + 1.5*math.Abs(math.Sin(4*math.Atan2(dy, dx)))
+			switch {
+			case d < 2:
+				img.Set(i, j, color.RGBA{60, 60, 70, 255})
+			case d < r-3:
+				img.Set(i, j, color.RGBA{170, 170, 180, 255})
+			case d < teeth:
+				img.Set(i, j, color.RGBA{210, 210, 220, 255})
+			}
+		}
+	}
+
+	sawImage = img
+	return sawImage
+}
+
+func blockImage() *ebiten.Image {
+	if fallingBlockImage != nil {
+		return fallingBlockImage
+	}
+
+	h := fallingBlockSize / 2
+	img := ebiten.NewImage(fallingBlockSize, h)
+	img.Fill(color.RGBA{110, 100, 90, 255})
+	for i := 0; i < fallingBlockSize; i++ {
+		img.Set(i, 0, color.RGBA{150, 140, 125, 255})
+		img.Set(i, h-1, color.RGBA{70, 62, 55, 255})
+	}
+	// трещины, чтобы было видно, что он ненадёжный
+	for _, p := range [][2]int{{6, 3}, {7, 4}, {7, 5}, {8, 6}, {20, 8}, {21, 9}, {21, 10}, {22, 11}} {
+		img.Set(p[0], p[1], color.RGBA{60, 52, 45, 255})
+	}
+
+	fallingBlockImage = img
+	return fallingBlockImage
+}
+
+func crusherBlockImage() *ebiten.Image {
+	if crusherImage != nil {
+		return crusherImage
+	}
+
+	img := ebiten.NewImage(crusherWidth, crusherHeight)
+	for i := 0; i < crusherWidth; i++ {
+		for j := 0; j < crusherHeight; j++ {
+			switch {
+			case j >= crusherHeight-4:
+				// teeth along the bottom edge
+				if i%4 < 4-(j-(crusherHeight-4)) {
+					img.Set(i, j, color.RGBA{190, 190, 200, 255})
+				}
+			case i == 0 || j == 0 || i == crusherWidth-1:
+				img.Set(i, j, color.RGBA{120, 120, 130, 255})
+			default:
+				img.Set(i, j, color.RGBA{80, 80, 95, 255})
+			}
+		}
+	}
+
+	crusherImage = img
+	return crusherImage
+}
+
+func poolImage(width, height int, body, surface color.RGBA) *ebiten.Image {
+	img := ebiten.NewImage(max(1, width), max(1, height))
+	img.Fill(body)
+	for i := 0; i < width; i++ {
+		img.Set(i, 0, surface)
+		if i%7 < 3 {
+			img.Set(i, 1, surface)
+		}
+	}
+	return img
+}
@@ -0,0 +1,105 @@
+package assets
+
+import (
+	_ "embed"
+	"fmt"
+
+	"github.com/game-jam-2026/dead-jump/internal/ecs"
+	"github.com/game-jam-2026/dead-jump/internal/ecs/components"
+	"github.com/game-jam-2026/dead-jump/pkg/linalg"
+)
+
+//go:embed img/hero_sheet.json
+var heroSheetJSON []byte
+
+var heroSheet *SpriteSheet
+
+var heroClips = []struct {
+	state components.AnimationState
+	tag   string
+	loop  bool
+}{
+	{components.AnimIdle, "idle", true},
+	{components.AnimRun, "run", true},
+	{components.AnimJumpRise, "jump-rise", true},
+	{components.AnimFall, "fall", true},
+	{components.AnimLand, "land", false},
+	{components.AnimWallSlide, "wall-slide", true},
+	{components.AnimDeath, "death", false},
+}
+
+// HeroSheet packs the hero frames into one atlas and describes them with an
+// Aseprite export, so a drawn sheet can later replace the packed one as is.
+func HeroSheet() *SpriteSheet {
+	if heroSheet != nil {
+		return heroSheet
+	}
+
+	packed := PackSpriteSheet(Image(ImageHero), Image(ImageHeroJump), Image(ImageDeadHero))
+	sheet, err := LoadAsepriteSheet(packed.Image, heroSheetJSON)
+	if err != nil {
+		fmt.Println("Error loading hero sheet:", err)
+		sheet = packed
+	}
+
+	heroSheet = sheet
+	return heroSheet
+}
+
+func NewHeroAnimator() components.CharacterAnimator {
+	sheet := HeroSheet()
+
+	animator := components.CharacterAnimator{
+		Clips: make(map[components.AnimationState]components.AnimationClip),
+		State: components.AnimIdle,
+	}
+
+	for _, c := range heroClips {
+		clip, err := sheet.Clip(c.tag, c.loop)
+		if err != nil {
+			fmt.Println(err)
+			continue
+		}
+		animator.Clips[c.state] = clip
+	}
+
+	return animator
+}
+
+// CreateGhost creates a translucent, non-colliding copy of the hero that
+// replays frames.
+func CreateGhost(w *ecs.World, frames []components.GhostFrame, alpha float32) ecs.EntityID {
+	entity := w.CreateEntity()
+
+	animator := NewHeroAnimator()
+	sprite := components.Sprite{Image: animator.CurrentFrame()}
+	sprite.ColorScale.Scale(0.6, 0.8, 1, 1)
+	sprite.ColorScale.ScaleAlpha(alpha)
+
+	var start linalg.Vector2
+	if len(frames) > 0 {
+		start = linalg.Vector2{X: frames[0].X, Y: frames[0].Y}
+	}
+
+	w.SetComponent(entity, components.Position{Vector: start})
+	w.SetComponent(entity, sprite)
+	w.SetComponent(entity, animator)
+	w.SetComponent(entity, components.Ghost{Frames: frames})
+
+	return entity
+}
+
+// CreateEcho creates a tinted hero that replays the inputs of a previous
+// life. It collides with the level like the character does.
+func CreateEcho(w *ecs.World, x, y float64, inputs []components.Button) ecs.EntityID {
+	entity := createHero(w, x, y, 1)
+
+	if sprite, err := ecs.GetComponent[components.Sprite](w, entity); err == nil {
+		sprite.ColorScale.Scale(0.8, 0.6, 1, 1)
+		sprite.ColorScale.ScaleAlpha(0.7)
+		w.SetComponent(entity, *sprite)
+	}
+	w.SetComponent(entity, components.Echo{Inputs: inputs})
+
+	return entity
+}
@@ -0,0 +1,52 @@
+package assets
+
+import (
+	"embed"
+	"path/filepath"
+	"runtime"
+
+	"github.com/hajimehoshi/ebiten/v2"
+
+	"github.com/game-jam-2026/dead-jump/internal/assets/registry"
+)
+
+//go:embed img/*.png
+var imageFS embed.FS
+
+var Images = registry.New(imageFS, "img")
+
+const (
+	ImageHero               = "hero"
+	ImageHeroJump           = "hero_jump"
+	ImageDeadHero           = "dead_hero"
+	ImageSpike              = "spike"
+	ImageGround             = "ground"
+	ImageOrange             = "orange"
+	ImageTombstone1         = "tombstone1"
+	ImageTombstone2         = "tombstone2"
+	ImageTombstone3         = "tombstone3"
+	ImageWallLeft           = "wall_left"
+	ImageWallRight          = "wall_right"
+	ImageFirLeft            = "fir_left"
+	ImageFirRight           = "fir_right"
+	ImageMoon               = "moon"
+	ImageCannonLeft         = "cannon_left"
+	ImageCannonTop          = "cannon_top"
+	ImageCannonRight        = "cannon_right"
+	ImageTileGroundTextured = "tile_ground_textured"
+	ImageTileGroundGrass    = "tile_ground_grass"
+	ImageTileTree           = "tile_tree"
+	ImageTileColumn         = "tile_column"
+)
+
+func init() {
+	// in debug builds images are reloaded from the source tree on change
+	_, file, _, ok := runtime.Caller(0)
+	if ok {
+		Images.Watch(filepath.Join(filepath.Dir(file), "img"))
+	}
+}
+
+func Image(name string) *ebiten.Image {
+	return Images.Image(name)
+}
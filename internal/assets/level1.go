@@ -3,11 +3,12 @@ package assets
 import (
 	"github.com/game-jam-2026/dead-jump/internal/ecs"
 	"github.com/game-jam-2026/dead-jump/internal/ecs/components"
+	"github.com/game-jam-2026/dead-jump/internal/game"
 	"github.com/game-jam-2026/dead-jump/internal/physics"
 	"github.com/game-jam-2026/dead-jump/pkg/linalg"
 )
 
-func LoadLevel1() *ecs.World {
+func LoadLevel1(p game.Profile) *ecs.World {
 	w := ecs.NewWorld()
 	CreateAudioManager(w)
 	CreateLifeCounter(w, 5)
@@ -15,6 +16,8 @@ func LoadLevel1() *ecs.World {
 
 	playerID := CreateCharacter(w, 20, 50, 1)
 
+	CreateForestBackdrop(w)
+
 	CreateGround(w, 0, 210, 24, 24, components.Repeatable{
 		Direction: linalg.Vector2{X: 1},
 		Count:     2,
@@ -30,6 +33,8 @@ func LoadLevel1() *ecs.World {
 		Count:     5,
 	})
 
+	CreateFruit(w, 100, 168)
+
 	CreateLevelFinish(w, 290, 194)
 
 	camera := components.NewCamera(320, 240)
@@ -43,8 +48,11 @@ func LoadLevel1() *ecs.World {
 	camera.MaxX = 320
 	camera.MinY = 0
 	w.SetResource(camera)
+	w.SetResource(components.NewLevelBounds(320, 240))
 
 	w.SetResource(*physics.DefaultConfig())
 
+	ApplyProfile(w, p)
+
 	return w
 }
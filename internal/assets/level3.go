@@ -6,6 +6,7 @@ import (
 
 	"github.com/game-jam-2026/dead-jump/internal/ecs"
 	"github.com/game-jam-2026/dead-jump/internal/ecs/components"
+	"github.com/game-jam-2026/dead-jump/internal/game"
 	"github.com/game-jam-2026/dead-jump/internal/physics"
 	"github.com/game-jam-2026/dead-jump/pkg/linalg"
 
@@ -13,7 +14,7 @@ import (
 	"github.com/solarlune/resolv"
 )
 
-func LoadLevel2() *ecs.World {
+func LoadLevel2(p game.Profile) *ecs.World {
 	w := ecs.NewWorld()
 	CreateAudioManager(w)
 	CreateLifeCounter(w, 3)
@@ -25,12 +26,15 @@ func LoadLevel2() *ecs.World {
 		Direction: linalg.Vector2{X: 1},
 		Count:     2,
 	})
-	CreateCannon(w, 32, 180, -math.Pi/2, 0)
+	upCannon := DefaultCannonConfig()
+	upCannon.Direction = -math.Pi / 2
+	CreateCannon(w, 32, 180, upCannon)
 
-	CreateExteriorObject(w, 220, 30, MoonImage)
-	CreateExteriorObject(w, -10, 162, FirLeftImage)
-	CreateExteriorObject(w, 128, 100, FirLeftImage)
-	CreateExteriorObject(w, 160, 100, FirRightImage)
+	CreateForestBackdrop(w)
+	CreateMoonLayer(w, 220, 30)
+	CreateExteriorObject(w, -10, 162, Image(ImageFirLeft))
+	CreateExteriorObject(w, 128, 100, Image(ImageFirLeft))
+	CreateExteriorObject(w, 160, 100, Image(ImageFirRight))
 
 	CreateSpike(w, 64, 215, components.Repeatable{
 		Direction: linalg.Vector2{X: 1},
@@ -63,6 +67,7 @@ func LoadLevel2() *ecs.World {
 
 	createFloor(w, -20, 0, 20, 320)
 	createFloor(w, 320, 0, 20, 320)
+	CreateFruit(w, 136, 126)
 	CreateLevelFinish(w, 264, 190)
 
 	camera := components.NewCamera(320, 240)
@@ -76,9 +81,12 @@ func LoadLevel2() *ecs.World {
 	camera.MaxX = 320
 	camera.MinY = 0
 	w.SetResource(camera)
+	w.SetResource(components.NewLevelBounds(320, 240))
 
 	w.SetResource(*physics.DefaultConfig())
 
+	ApplyProfile(w, p)
+
 	return w
 }
 
@@ -111,9 +119,9 @@ func createWallBlock(w *ecs.World, x, y, width, height float64, zIndex int, isRi
 		Vector: linalg.Vector2{X: x, Y: y},
 	})
 
-	img := WallLeftImage
+	img := Image(ImageWallLeft)
 	if isRight {
-		img = WallRightImage
+		img = Image(ImageWallRight)
 	}
 
 	w.SetComponent(entity, components.Sprite{
@@ -0,0 +1,250 @@
+package assets
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"os"
+
+	"github.com/game-jam-2026/dead-jump/internal/ecs"
+	"github.com/game-jam-2026/dead-jump/internal/ecs/components"
+	"github.com/game-jam-2026/dead-jump/internal/game"
+	"github.com/game-jam-2026/dead-jump/internal/physics"
+	"github.com/game-jam-2026/dead-jump/pkg/linalg"
+)
+
+type PlacementKind string
+
+const (
+	PlaceGround     PlacementKind = "ground"
+	PlacePlatform   PlacementKind = "platform"
+	PlaceSpike      PlacementKind = "spike"
+	PlaceCannon     PlacementKind = "cannon"
+	PlaceStart      PlacementKind = "start"
+	PlaceFinish     PlacementKind = "finish"
+	PlaceDecoration PlacementKind = "decoration"
+	PlaceFruit      PlacementKind = "fruit"
+)
+
+var PlacementKinds = []PlacementKind{
+	PlaceGround,
+	PlacePlatform,
+	PlaceSpike,
+	PlaceCannon,
+	PlaceStart,
+	PlaceFinish,
+	PlaceDecoration,
+	PlaceFruit,
+}
+
+// Placement is one entity of a level file. Fields that don't apply to the
+// kind are ignored.
+type Placement struct {
+	Kind PlacementKind `json:"kind"`
+	X    float64       `json:"x"`
+	Y    float64       `json:"y"`
+
+	// ground, platform, spike
+	Count    int  `json:"count,omitempty"`
+	Vertical bool `json:"vertical,omitempty"`
+
+	// cannon; Facing picks the sprite: -1 left, 0 top, 1 right
+	Direction       float64 `json:"direction,omitempty"`
+	Facing          int     `json:"facing,omitempty"`
+	FireRate        int     `json:"fireRate,omitempty"`
+	ProjectileSpeed float64 `json:"projectileSpeed,omitempty"`
+	Mode            int     `json:"mode,omitempty"`
+	SpreadCount     int     `json:"spreadCount,omitempty"`
+	Projectile      string  `json:"projectile,omitempty"`
+
+	// decoration
+	Image  string `json:"image,omitempty"`
+	ZIndex int    `json:"zIndex,omitempty"`
+}
+
+type LevelFile struct {
+	Name       string      `json:"name"`
+	Width      float64     `json:"width"`
+	Height     float64     `json:"height"`
+	Lives      int         `json:"lives"`
+	Sacrifices int         `json:"sacrifices,omitempty"` // 0 unlimited, -1 disabled
+	Backdrop   bool        `json:"backdrop"`
+	Placements []Placement `json:"placements"`
+}
+
+// NewLevelFile returns a minimal playable level: a strip of ground, a start
+// point and a finish.
+func NewLevelFile() *LevelFile {
+	return &LevelFile{
+		Name:     "custom",
+		Width:    320,
+		Height:   240,
+		Lives:    5,
+		Backdrop: true,
+		Placements: []Placement{
+			NewPlacement(PlaceGround, 0, 208),
+			NewPlacement(PlaceStart, 16, 176),
+			NewPlacement(PlaceFinish, 288, 192),
+		},
+	}
+}
+
+// NewPlacement returns a placement of the given kind with the same defaults
+// the hand-written levels use.
+func NewPlacement(kind PlacementKind, x, y float64) Placement {
+	p := Placement{Kind: kind, X: x, Y: y}
+
+	switch kind {
+	case PlaceGround:
+		p.Count = 10
+	case PlacePlatform, PlaceSpike:
+		p.Count = 3
+	case PlaceCannon:
+		cfg := DefaultCannonConfig()
+		p.Direction = cfg.Direction
+		p.FireRate = cfg.FireRate
+		p.ProjectileSpeed = cfg.ProjectileSpeed
+		p.SpreadCount = cfg.SpreadCount
+	case PlaceDecoration:
+		p.Image = ImageTombstone1
+	}
+
+	return p
+}
+
+func ReadLevelFile(path string) (*LevelFile, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	level := &LevelFile{}
+	if err := json.Unmarshal(data, level); err != nil {
+		return nil, fmt.Errorf("level %s: %w", path, err)
+	}
+	return level, nil
+}
+
+func (l *LevelFile) WriteFile(path string) error {
+	data, err := json.MarshalIndent(l, "", "  ")
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(path, append(data, '\n'), 0o644)
+}
+
+// Build creates the level geometry without the character. The returned
+// entities are index-aligned with Placements.
+func (l *LevelFile) Build() (*ecs.World, []ecs.EntityID) {
+	w := ecs.NewWorld()
+
+	if l.Backdrop {
+		CreateForestBackdrop(w)
+	}
+
+	entities := make([]ecs.EntityID, len(l.Placements))
+	for i, p := range l.Placements {
+		entities[i] = p.Create(w)
+	}
+
+	return w, entities
+}
+
+// Load builds a playable world; it has the same shape as the hand-written
+// level loaders.
+func (l *LevelFile) Load(p game.Profile) *ecs.World {
+	w, _ := l.Build()
+	CreateAudioManager(w)
+	CreateLifeCounter(w, l.Lives)
+	SetSacrifices(w, l.Sacrifices)
+
+	start := l.StartPosition()
+	playerID := CreateCharacter(w, start.X, start.Y, 1)
+
+	camera := components.NewCamera(320, 240)
+	camera.Target = int64(playerID)
+	camera.SetBounds(0, 0, l.Width, l.Height)
+	camera.Smoothing = 0.1
+	camera.DeadZoneX = 20
+	camera.DeadZoneY = 15
+	w.SetResource(camera)
+	w.SetResource(components.NewLevelBounds(l.Width, l.Height))
+
+	w.SetResource(*physics.DefaultConfig())
+
+	ApplyProfile(w, p)
+
+	return w
+}
+
+func (l *LevelFile) StartPosition() linalg.Vector2 {
+	for _, p := range l.Placements {
+		if p.Kind == PlaceStart {
+			return linalg.Vector2{X: p.X, Y: p.Y}
+		}
+	}
+	return linalg.Zero()
+}
+
+func (p Placement) Create(w *ecs.World) ecs.EntityID {
+	switch p.Kind {
+	case PlaceGround:
+		return CreateGround(w, p.X, p.Y, 32, 32, p.repeatable())
+	case PlacePlatform:
+		return CreatePlatform(w, p.X, p.Y, 32, 32, p.repeatable())
+	case PlaceSpike:
+		return CreateSpike(w, p.X, p.Y, p.repeatable())
+	case PlaceCannon:
+		return CreateCannon(w, p.X, p.Y, p.cannonConfig())
+	case PlaceStart:
+		return CreateStartPoint(w, p.X, p.Y)
+	case PlaceFinish:
+		return CreateLevelFinish(w, p.X, p.Y)
+	case PlaceFruit:
+		return CreateFruit(w, p.X, p.Y)
+	case PlaceDecoration:
+		entity := CreateExteriorObject(w, p.X, p.Y, Image(p.Image))
+		if sprite, err := ecs.GetComponent[components.Sprite](w, entity); err == nil {
+			sprite.ZIndex = p.ZIndex
+			w.SetComponent(entity, *sprite)
+		}
+		return entity
+	}
+
+	fmt.Println("level: unknown placement kind", p.Kind)
+	return w.CreateEntity()
+}
+
+// cannonConfig maps the placement onto a cannon config; sweeping cannons
+// swing 45 degrees to each side of Direction.
+func (p Placement) cannonConfig() CannonConfig {
+	cfg := DefaultCannonConfig()
+	cfg.Direction = p.Direction
+	cfg.Facing = p.Facing
+	cfg.Mode = components.CannonMode(p.Mode)
+	cfg.ProjectileType = p.Projectile
+	cfg.SweepFrom = p.Direction - math.Pi/4
+	cfg.SweepTo = p.Direction + math.Pi/4
+	if p.FireRate > 0 {
+		cfg.FireRate = p.FireRate
+	}
+	if p.ProjectileSpeed > 0 {
+		cfg.ProjectileSpeed = p.ProjectileSpeed
+	}
+	if p.SpreadCount > 0 {
+		cfg.SpreadCount = p.SpreadCount
+		cfg.SpreadAngle = math.Pi / 6
+	}
+	return cfg
+}
+
+func (p Placement) repeatable() components.Repeatable {
+	rep := components.Repeatable{
+		Direction: linalg.Vector2{X: 1},
+		Count:     max(1, p.Count),
+	}
+	if p.Vertical {
+		rep.Direction = linalg.Vector2{Y: 1}
+	}
+	return rep
+}
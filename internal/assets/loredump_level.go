@@ -8,13 +8,14 @@ import (
 
 	"github.com/game-jam-2026/dead-jump/internal/ecs"
 	"github.com/game-jam-2026/dead-jump/internal/ecs/components"
+	"github.com/game-jam-2026/dead-jump/internal/game"
 	"github.com/game-jam-2026/dead-jump/internal/physics"
 	"github.com/game-jam-2026/dead-jump/pkg/linalg"
 )
 
 const loreText = "You are a mage in a dying world. Your life is supported by the magic fruits, even if your body dies, you are revived, experiencing the same places over and over again. Try to find out what has happened by collecting warp fruits to traverse the world."
 
-func LoadLoreDumpLevel() *ecs.World {
+func LoadLoreDumpLevel(p game.Profile) *ecs.World {
 	w := ecs.NewWorld()
 	CreateAudioManager(w)
 	CreateLifeCounter(w, 3)
@@ -48,9 +49,12 @@ func LoadLoreDumpLevel() *ecs.World {
 	camera.MaxX = 320
 	camera.MaxY = 240
 	w.SetResource(camera)
+	w.SetResource(components.NewLevelBounds(levelWidth, levelHeight))
 
 	w.SetResource(*physics.DefaultConfig())
 
+	ApplyProfile(w, p)
+
 	return w
 }
 
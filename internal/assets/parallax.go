@@ -0,0 +1,148 @@
+package assets
+
+import (
+	"image/color"
+	"math/rand"
+
+	"github.com/hajimehoshi/ebiten/v2"
+
+	"github.com/game-jam-2026/dead-jump/internal/ecs"
+	"github.com/game-jam-2026/dead-jump/internal/ecs/components"
+	"github.com/game-jam-2026/dead-jump/pkg/linalg"
+)
+
+const (
+	SkyLayerZ        = -30
+	MoonLayerZ       = -25
+	FarForestLayerZ  = -20
+	NearForestLayerZ = -10
+)
+
+var (
+	skyLayerImage        *ebiten.Image
+	farForestLayerImage  *ebiten.Image
+	nearForestLayerImage *ebiten.Image
+)
+
+func CreateParallaxLayer(w *ecs.World, x, y float64, img *ebiten.Image, zIndex int, layer components.ParallaxLayer) ecs.EntityID {
+	entity := w.CreateEntity()
+
+	w.SetComponent(entity, components.Position{
+		Vector: linalg.Vector2{X: x, Y: y},
+	})
+	w.SetComponent(entity, components.Sprite{
+		Image:  img,
+		ZIndex: zIndex,
+	})
+	w.SetComponent(entity, layer)
+
+	return entity
+}
+
+func CreateSkyLayer(w *ecs.World) ecs.EntityID {
+	if skyLayerImage == nil {
+		skyLayerImage = buildSkyImage(64, 240)
+	}
+
+	return CreateParallaxLayer(w, 0, 0, skyLayerImage, SkyLayerZ, components.ParallaxLayer{
+		ScrollX: 0.02,
+		RepeatX: true,
+		Anchor:  components.ParallaxAnchorTop,
+	})
+}
+
+func CreateMoonLayer(w *ecs.World, x, y float64) ecs.EntityID {
+	return CreateParallaxLayer(w, x, y, Image(ImageMoon), MoonLayerZ, components.ParallaxLayer{
+		ScrollX: 0.05,
+		ScrollY: 0.05,
+		Anchor:  components.ParallaxAnchorTop,
+	})
+}
+
+// CreateFarForestLayer places a dark row of firs sitting offsetY pixels above
+// the bottom of the viewport.
+func CreateFarForestLayer(w *ecs.World, offsetY float64) ecs.EntityID {
+	if farForestLayerImage == nil {
+		farForestLayerImage = buildForestImage(160, 0.55, 0.2, 11)
+	}
+
+	return CreateParallaxLayer(w, 0, -offsetY, farForestLayerImage, FarForestLayerZ, components.ParallaxLayer{
+		ScrollX: 0.2,
+		ScrollY: 0.1,
+		RepeatX: true,
+		Anchor:  components.ParallaxAnchorBottom,
+	})
+}
+
+func CreateNearForestLayer(w *ecs.World, offsetY float64) ecs.EntityID {
+	if nearForestLayerImage == nil {
+		nearForestLayerImage = buildForestImage(224, 0.8, 0.45, 23)
+	}
+
+	return CreateParallaxLayer(w, 0, -offsetY, nearForestLayerImage, NearForestLayerZ, components.ParallaxLayer{
+		ScrollX: 0.5,
+		ScrollY: 0.3,
+		RepeatX: true,
+		Anchor:  components.ParallaxAnchorBottom,
+	})
+}
+
+func CreateForestBackdrop(w *ecs.World) {
+	CreateSkyLayer(w)
+	CreateFarForestLayer(w, 24)
+	CreateNearForestLayer(w, 0)
+}
+
+func buildSkyImage(width, height int) *ebiten.Image {
+	img := ebiten.NewImage(width, height)
+
+	top := color.RGBA{8, 6, 20, 255}
+	bottom := color.RGBA{40, 26, 52, 255}
+	for y := 0; y < height; y++ {
+		t := float64(y) / float64(height-1)
+		c := color.RGBA{
+			R: uint8(float64(top.R) + (float64(bottom.R)-float64(top.R))*t),
+			G: uint8(float64(top.G) + (float64(bottom.G)-float64(top.G))*t),
+			B: uint8(float64(top.B) + (float64(bottom.B)-float64(top.B))*t),
+			A: 255,
+		}
+		for x := 0; x < width; x++ {
+			img.Set(x, y, c)
+		}
+	}
+
+	// фиксированный сид, чтобы звёзды не прыгали между уровнями
+	rng := rand.New(rand.NewSource(7))
+	for i := 0; i < 12; i++ {
+		x := rng.Intn(width)
+		y := rng.Intn(height / 2)
+		brightness := uint8(120 + rng.Intn(100))
+		img.Set(x, y, color.RGBA{brightness, brightness, brightness, 255})
+	}
+
+	return img
+}
+
+func buildForestImage(width int, scale, brightness float64, seed int64) *ebiten.Image {
+	firBounds := Image(ImageFirLeft).Bounds()
+	firW := float64(firBounds.Dx()) * scale
+	firH := float64(firBounds.Dy()) * scale
+
+	img := ebiten.NewImage(width, int(firH))
+	rng := rand.New(rand.NewSource(seed))
+
+	for x := 0.0; x+firW <= float64(width); x += firW * (0.5 + rng.Float64()*0.4) {
+		fir := Image(ImageFirLeft)
+		if rng.Intn(2) == 0 {
+			fir = Image(ImageFirRight)
+		}
+
+		op := &ebiten.DrawImageOptions{}
+		op.GeoM.Scale(scale, scale)
+		op.GeoM.Translate(x, 0)
+		op.ColorScale.Scale(float32(brightness), float32(brightness), float32(brightness*1.2), 1)
+		img.DrawImage(fir, op)
+	}
+
+	return img
+}
@@ -6,6 +6,7 @@ import (
 
 	"github.com/game-jam-2026/dead-jump/internal/ecs"
 	"github.com/game-jam-2026/dead-jump/internal/ecs/components"
+	"github.com/game-jam-2026/dead-jump/internal/game"
 	"github.com/game-jam-2026/dead-jump/internal/physics"
 	"github.com/game-jam-2026/dead-jump/pkg/linalg"
 
@@ -18,7 +19,7 @@ const (
 	WorldHeight = 400
 )
 
-func LoadPhysicsTestLevel() *ecs.World {
+func LoadPhysicsTestLevel(p game.Profile) *ecs.World {
 	w := ecs.NewWorld()
 	CreateAudioManager(w)
 
@@ -65,9 +66,12 @@ func LoadPhysicsTestLevel() *ecs.World {
 	camera.DeadZoneX = 20
 	camera.DeadZoneY = 15
 	w.SetResource(camera)
+	w.SetResource(components.NewLevelBounds(WorldWidth, WorldHeight))
 
 	w.SetResource(*physics.DefaultConfig())
 
+	ApplyProfile(w, p)
+
 	return w
 }
 
@@ -0,0 +1,54 @@
+package assets
+
+import (
+	"math"
+	"reflect"
+	"slices"
+
+	"github.com/game-jam-2026/dead-jump/internal/ecs"
+	"github.com/game-jam-2026/dead-jump/internal/ecs/components"
+	"github.com/game-jam-2026/dead-jump/internal/game"
+)
+
+// ApplyProfile tunes a freshly loaded level to the difficulty: cannons fire
+// faster or slower and the rules the systems need are stored in the world.
+func ApplyProfile(w *ecs.World, p game.Profile) {
+	cannons := w.GetEntities(reflect.TypeOf((*components.Cannon)(nil)).Elem())
+	for _, e := range cannons {
+		cannon, err := ecs.GetComponent[components.Cannon](w, e)
+		if err != nil {
+			continue
+		}
+
+		// the timeline may be shared with the level data, scale a copy
+		cannon.Timeline = slices.Clone(cannon.Timeline)
+		if p.FireRate > 0 {
+			cannon.FireRate = scaleTicks(cannon.FireRate, p.FireRate)
+			for i := range cannon.Timeline {
+				cannon.Timeline[i].Delay = scaleTicks(cannon.Timeline[i].Delay, p.FireRate)
+			}
+		}
+		if p.ProjectileSpeed > 0 {
+			cannon.ProjectileSpeed *= p.ProjectileSpeed
+			for i := range cannon.Timeline {
+				cannon.Timeline[i].Speed *= p.ProjectileSpeed
+			}
+		}
+		w.SetComponent(e, *cannon)
+	}
+
+	w.SetResource(components.DifficultyRules{
+		CorpseDurability: p.CorpseDurability,
+		RespawnGrace:     p.RespawnGrace,
+	})
+}
+
+// scaleTicks turns an interval into the one of a rate multiplied by rate. A
+// zero delay means "together with the previous step" and stays zero, any
+// other wait is kept at least one tick long.
+func scaleTicks(ticks int, rate float64) int {
+	if ticks <= 0 {
+		return ticks
+	}
+	return max(1, int(math.Round(float64(ticks)/rate)))
+}
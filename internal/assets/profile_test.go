@@ -0,0 +1,140 @@
+package assets
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/game-jam-2026/dead-jump/internal/ecs"
+	"github.com/game-jam-2026/dead-jump/internal/ecs/components"
+	"github.com/game-jam-2026/dead-jump/internal/game"
+)
+
+func TestApplyProfile(t *testing.T) {
+	tests := []struct {
+		name       string
+		profile    game.Profile
+		fireRate   int
+		speed      float64
+		delays     []int
+		shotSpeeds []float64
+	}{
+		{
+			name:       "normal keeps the level as built",
+			profile:    game.ProfileFor(game.DifficultyNormal),
+			fireRate:   120,
+			speed:      4,
+			delays:     []int{60, 0, 30},
+			shotSpeeds: []float64{0, 5, 2},
+		},
+		{
+			name:       "easy fires slower",
+			profile:    game.ProfileFor(game.DifficultyEasy),
+			fireRate:   171,
+			speed:      3.2,
+			delays:     []int{86, 0, 43},
+			shotSpeeds: []float64{0, 4, 1.6},
+		},
+		{
+			name:       "hard fires faster",
+			profile:    game.ProfileFor(game.DifficultyHard),
+			fireRate:   92,
+			speed:      4.8,
+			delays:     []int{46, 0, 23},
+			shotSpeeds: []float64{0, 6, 2.4},
+		},
+		{
+			name:       "zero multipliers are ignored",
+			profile:    game.Profile{},
+			fireRate:   120,
+			speed:      4,
+			delays:     []int{60, 0, 30},
+			shotSpeeds: []float64{0, 5, 2},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			timeline := []components.CannonShot{
+				{Delay: 60},
+				{Delay: 0, Speed: 5},
+				{Delay: 30, Speed: 2},
+			}
+			cannon := components.DefaultCannon()
+			cannon.Timeline = timeline
+
+			w := ecs.NewWorld()
+			e := w.CreateEntity()
+			w.SetComponent(e, cannon)
+
+			ApplyProfile(w, tt.profile)
+
+			got, err := ecs.GetComponent[components.Cannon](w, e)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got.FireRate != tt.fireRate {
+				t.Errorf("FireRate = %d, want %d", got.FireRate, tt.fireRate)
+			}
+			if !approx(got.ProjectileSpeed, tt.speed) {
+				t.Errorf("ProjectileSpeed = %v, want %v", got.ProjectileSpeed, tt.speed)
+			}
+			for i, shot := range got.Timeline {
+				if shot.Delay != tt.delays[i] {
+					t.Errorf("Timeline[%d].Delay = %d, want %d", i, shot.Delay, tt.delays[i])
+				}
+				if !approx(shot.Speed, tt.shotSpeeds[i]) {
+					t.Errorf("Timeline[%d].Speed = %v, want %v", i, shot.Speed, tt.shotSpeeds[i])
+				}
+			}
+
+			// the level's own timeline stays untouched for the next load
+			if !slices.Equal(timeline, []components.CannonShot{{Delay: 60}, {Delay: 0, Speed: 5}, {Delay: 30, Speed: 2}}) {
+				t.Errorf("source timeline changed: %v", timeline)
+			}
+
+			rules, err := ecs.GetResource[components.DifficultyRules](w)
+			if err != nil {
+				t.Fatal(err)
+			}
+			want := components.DifficultyRules{
+				CorpseDurability: tt.profile.CorpseDurability,
+				RespawnGrace:     tt.profile.RespawnGrace,
+			}
+			if *rules != want {
+				t.Errorf("DifficultyRules = %+v, want %+v", *rules, want)
+			}
+		})
+	}
+}
+
+func TestApplyProfileKeepsTimelineWithoutDelays(t *testing.T) {
+	for _, d := range []game.Difficulty{game.DifficultyEasy, game.DifficultyNormal, game.DifficultyHard} {
+		cannon := components.DefaultCannon()
+		cannon.Timeline = []components.CannonShot{{}, {Speed: 5}}
+
+		w := ecs.NewWorld()
+		e := w.CreateEntity()
+		w.SetComponent(e, cannon)
+
+		profile := game.ProfileFor(d)
+		ApplyProfile(w, profile)
+
+		got, err := ecs.GetComponent[components.Cannon](w, e)
+		if err != nil {
+			t.Fatal(err)
+		}
+		// a volley without waits still fires once per FireRate
+		if got.Interval() != got.FireRate {
+			t.Errorf("%v: Interval() = %d, want FireRate %d", d, got.Interval(), got.FireRate)
+		}
+		for i, shot := range got.Timeline {
+			if shot.Delay != 0 {
+				t.Errorf("%v: Timeline[%d].Delay = %d, want 0", d, i, shot.Delay)
+			}
+		}
+	}
+}
+
+func approx(a, b float64) bool {
+	return a-b < 1e-9 && b-a < 1e-9
+}
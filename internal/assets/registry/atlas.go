@@ -0,0 +1,47 @@
+package registry
+
+import (
+	"image"
+
+	"github.com/hajimehoshi/ebiten/v2"
+)
+
+const (
+	atlasPageSize = 512
+	atlasPadding  = 1
+)
+
+// atlasPacker hands out regions of shared pages using simple shelf packing.
+// Pages are unmanaged so ebiten doesn't move them into its own atlas again.
+type atlasPacker struct {
+	page        *ebiten.Image
+	x, y        int
+	shelfHeight int
+}
+
+func newAtlasPacker() *atlasPacker {
+	return &atlasPacker{}
+}
+
+func (p *atlasPacker) alloc(w, h int) *ebiten.Image {
+	if w+atlasPadding > atlasPageSize || h+atlasPadding > atlasPageSize {
+		return ebiten.NewImageWithOptions(image.Rect(0, 0, w, h), &ebiten.NewImageOptions{Unmanaged: true})
+	}
+
+	if p.page != nil && p.x+w+atlasPadding > atlasPageSize {
+		p.x = 0
+		p.y += p.shelfHeight
+		p.shelfHeight = 0
+	}
+
+	if p.page == nil || p.y+h+atlasPadding > atlasPageSize {
+		p.page = ebiten.NewImageWithOptions(image.Rect(0, 0, atlasPageSize, atlasPageSize), &ebiten.NewImageOptions{Unmanaged: true})
+		p.x, p.y, p.shelfHeight = 0, 0, 0
+	}
+
+	rect := image.Rect(p.x, p.y, p.x+w, p.y+h)
+	p.x += w + atlasPadding
+	p.shelfHeight = max(p.shelfHeight, h+atlasPadding)
+
+	return p.page.SubImage(rect).(*ebiten.Image)
+}
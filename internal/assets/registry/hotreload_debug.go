@@ -0,0 +1,81 @@
+//go:build debug
+
+package registry
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+)
+
+const hotReloadInterval = 30
+
+var (
+	watched       []*Registry
+	modTimes      = make(map[string]time.Time)
+	hotReloadTick int
+)
+
+// Watch makes the registry reload images from dir on disk whenever a file
+// changes. Only development builds (-tags debug) poll the disk.
+func (r *Registry) Watch(dir string) {
+	r.watchDir = dir
+	watched = append(watched, r)
+
+	for _, e := range r.entries {
+		if info, err := os.Stat(filepath.Join(dir, e.file)); err == nil {
+			modTimes[filepath.Join(dir, e.file)] = info.ModTime()
+		}
+	}
+}
+
+func PollHotReload() {
+	hotReloadTick++
+	if hotReloadTick < hotReloadInterval {
+		return
+	}
+	hotReloadTick = 0
+
+	for _, r := range watched {
+		r.reloadChanged()
+	}
+}
+
+func (r *Registry) reloadChanged() {
+	for name, e := range r.entries {
+		file := filepath.Join(r.watchDir, e.file)
+		info, err := os.Stat(file)
+		if err != nil || !info.ModTime().After(modTimes[file]) {
+			continue
+		}
+		modTimes[file] = info.ModTime()
+
+		if !e.loaded || e.image == nil {
+			continue
+		}
+
+		data, err := os.ReadFile(file)
+		if err != nil {
+			fmt.Println("registry:", err)
+			continue
+		}
+
+		rgba, err := decodeRGBA(data)
+		if err != nil {
+			fmt.Printf("registry: reload %s: %v\n", e.file, err)
+			continue
+		}
+
+		// спрайты держат указатель на старую картинку, поэтому пишем
+		// пиксели на то же место в атласе; размер менять нельзя
+		b := e.image.Bounds()
+		if rgba.Rect.Dx() != b.Dx() || rgba.Rect.Dy() != b.Dy() {
+			fmt.Printf("registry: %s changed size, restart to pick it up\n", name)
+			continue
+		}
+
+		e.image.WritePixels(rgba.Pix)
+		fmt.Printf("registry: reloaded %s\n", name)
+	}
+}
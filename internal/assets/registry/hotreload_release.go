@@ -0,0 +1,7 @@
+//go:build !debug
+
+package registry
+
+func (r *Registry) Watch(dir string) {}
+
+func PollHotReload() {}
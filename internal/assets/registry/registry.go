@@ -0,0 +1,170 @@
+package registry
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"image"
+	"image/color"
+	"image/draw"
+	_ "image/png"
+	"io/fs"
+	"path"
+	"sort"
+	"strings"
+
+	"github.com/hajimehoshi/ebiten/v2"
+)
+
+type entry struct {
+	file   string
+	image  *ebiten.Image
+	loaded bool
+	err    error
+}
+
+// Registry serves images by name (file name without extension) from a
+// directory of an fs.FS. Images are decoded on first use and packed into
+// shared atlas pages.
+type Registry struct {
+	fsys     fs.FS
+	dir      string
+	entries  map[string]*entry
+	names    map[*ebiten.Image]string
+	reported map[string]bool
+	packer   *atlasPacker
+
+	watchDir string
+}
+
+var placeholder *ebiten.Image
+
+func New(fsys fs.FS, dir string) *Registry {
+	r := &Registry{
+		fsys:     fsys,
+		dir:      dir,
+		entries:  make(map[string]*entry),
+		names:    make(map[*ebiten.Image]string),
+		reported: make(map[string]bool),
+		packer:   newAtlasPacker(),
+	}
+
+	files, err := fs.ReadDir(fsys, dir)
+	if err != nil {
+		fmt.Println("registry:", err)
+		return r
+	}
+
+	for _, f := range files {
+		if f.IsDir() || !isImageFile(f.Name()) {
+			continue
+		}
+		r.entries[nameOf(f.Name())] = &entry{file: f.Name()}
+	}
+
+	return r
+}
+
+func isImageFile(file string) bool {
+	return strings.EqualFold(path.Ext(file), ".png")
+}
+
+func nameOf(file string) string {
+	return strings.TrimSuffix(file, path.Ext(file))
+}
+
+// Load returns the named image, decoding and packing it on first use.
+func (r *Registry) Load(name string) (*ebiten.Image, error) {
+	e, ok := r.entries[name]
+	if !ok {
+		return nil, fmt.Errorf("registry: unknown image %q", name)
+	}
+
+	if !e.loaded {
+		e.loaded = true
+		e.image, e.err = r.decode(e.file)
+		if e.image != nil {
+			r.names[e.image] = name
+		}
+	}
+
+	return e.image, e.err
+}
+
+// Image is Load for call sites that can't handle a missing image: the error
+// is logged once and a magenta placeholder is returned instead.
+func (r *Registry) Image(name string) *ebiten.Image {
+	img, err := r.Load(name)
+	if err != nil {
+		if !r.reported[name] {
+			fmt.Println(err)
+			r.reported[name] = true
+		}
+		return placeholderImage()
+	}
+	return img
+}
+
+// LoadAll eagerly loads every image and reports all decode errors at once.
+func (r *Registry) LoadAll() error {
+	var errs []error
+	for _, name := range r.Names() {
+		if _, err := r.Load(name); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return errors.Join(errs...)
+}
+
+func (r *Registry) Names() []string {
+	names := make([]string, 0, len(r.entries))
+	for name := range r.entries {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// NameOf is the reverse lookup for images handed out by the registry.
+func (r *Registry) NameOf(img *ebiten.Image) (string, bool) {
+	name, ok := r.names[img]
+	return name, ok
+}
+
+func (r *Registry) decode(file string) (*ebiten.Image, error) {
+	data, err := fs.ReadFile(r.fsys, path.Join(r.dir, file))
+	if err != nil {
+		return nil, fmt.Errorf("registry: %w", err)
+	}
+
+	rgba, err := decodeRGBA(data)
+	if err != nil {
+		return nil, fmt.Errorf("registry: decode %s: %w", file, err)
+	}
+
+	img := r.packer.alloc(rgba.Rect.Dx(), rgba.Rect.Dy())
+	img.WritePixels(rgba.Pix)
+
+	return img, nil
+}
+
+func decodeRGBA(data []byte) (*image.RGBA, error) {
+	src, _, err := image.Decode(bytes.NewReader(data))
+	if err != nil {
+		return nil, err
+	}
+
+	b := src.Bounds()
+	rgba := image.NewRGBA(image.Rect(0, 0, b.Dx(), b.Dy()))
+	draw.Draw(rgba, rgba.Rect, src, b.Min, draw.Src)
+
+	return rgba, nil
+}
+
+func placeholderImage() *ebiten.Image {
+	if placeholder == nil {
+		placeholder = ebiten.NewImage(8, 8)
+		placeholder.Fill(color.RGBA{255, 0, 255, 255})
+	}
+	return placeholder
+}
@@ -65,6 +65,7 @@ func InitAudio() {
 	_ = audio.RegisterWAV(audio.SoundMenuConfirm, menuConfirmWAV)
 
 	_ = audio.RegisterMP3(audio.SoundCannonShot, cannonShotMP3)
+	audio.RegisterPCM(audio.SoundCannonCharge, synthChargeSound())
 	_ = audio.RegisterMP3(audio.SoundProjectileHit, projectileHitMP3)
 
 	_ = audio.RegisterMP3(audio.SoundDeath, spikeDeath1MP3)
@@ -81,4 +82,10 @@ func InitAudio() {
 	_ = audio.RegisterMP3(audio.SoundStep, step5MP3)
 	_ = audio.RegisterMP3(audio.SoundStep, step6MP3)
 	_ = audio.RegisterMP3(audio.SoundStep, step7MP3)
+
+	audio.RegisterPCM(audio.SoundPickup, synthPickupSound())
+	audio.RegisterPCM(audio.SoundDeathCrush, synthCrushSound())
+	audio.RegisterPCM(audio.SoundDeathBurn, synthBurnSound())
+	audio.RegisterPCM(audio.SoundDeathFall, synthFallSound())
+	audio.RegisterPCM(audio.SoundDeathSacrifice, synthSacrificeSound())
 }
@@ -0,0 +1,209 @@
+package assets
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"image"
+	"strings"
+
+	"github.com/hajimehoshi/ebiten/v2"
+
+	"github.com/game-jam-2026/dead-jump/internal/ecs/components"
+)
+
+const DefaultFrameTicks = 6
+
+type FrameTag struct {
+	From int
+	To   int
+}
+
+// SpriteSheet is an atlas image split into frame rectangles. Durations are in
+// simulation ticks and may be zero when the source has no timing data.
+type SpriteSheet struct {
+	Image     *ebiten.Image
+	Frames    []image.Rectangle
+	Durations []int
+	Tags      map[string]FrameTag
+
+	frameCache []*ebiten.Image
+}
+
+func NewSpriteSheet(img *ebiten.Image, frames []image.Rectangle) *SpriteSheet {
+	return &SpriteSheet{
+		Image:     img,
+		Frames:    frames,
+		Durations: make([]int, len(frames)),
+		Tags:      make(map[string]FrameTag),
+	}
+}
+
+func NewGridSpriteSheet(img *ebiten.Image, frameW, frameH int) *SpriteSheet {
+	bounds := img.Bounds()
+
+	var frames []image.Rectangle
+	for y := bounds.Min.Y; y+frameH <= bounds.Max.Y; y += frameH {
+		for x := bounds.Min.X; x+frameW <= bounds.Max.X; x += frameW {
+			frames = append(frames, image.Rect(x, y, x+frameW, y+frameH))
+		}
+	}
+
+	return NewSpriteSheet(img, frames)
+}
+
+// PackSpriteSheet lays the images out left to right in a single atlas.
+func PackSpriteSheet(images ...*ebiten.Image) *SpriteSheet {
+	width, height := 0, 0
+	for _, img := range images {
+		b := img.Bounds()
+		width += b.Dx()
+		height = max(height, b.Dy())
+	}
+
+	atlas := ebiten.NewImage(width, height)
+	frames := make([]image.Rectangle, 0, len(images))
+
+	x := 0
+	for _, img := range images {
+		b := img.Bounds()
+		op := &ebiten.DrawImageOptions{}
+		op.GeoM.Translate(float64(x), 0)
+		atlas.DrawImage(img, op)
+
+		frames = append(frames, image.Rect(x, 0, x+b.Dx(), b.Dy()))
+		x += b.Dx()
+	}
+
+	return NewSpriteSheet(atlas, frames)
+}
+
+type asepriteRect struct {
+	X int `json:"x"`
+	Y int `json:"y"`
+	W int `json:"w"`
+	H int `json:"h"`
+}
+
+type asepriteFrame struct {
+	Filename string       `json:"filename"`
+	Frame    asepriteRect `json:"frame"`
+	Duration int          `json:"duration"`
+}
+
+type asepriteExport struct {
+	Frames json.RawMessage `json:"frames"`
+	Meta   struct {
+		FrameTags []struct {
+			Name string `json:"name"`
+			From int    `json:"from"`
+			To   int    `json:"to"`
+		} `json:"frameTags"`
+	} `json:"meta"`
+}
+
+// LoadAsepriteSheet reads frame rectangles, durations and tags from an Aseprite
+// JSON export. Both the "array" and "hash" frame layouts are supported.
+func LoadAsepriteSheet(img *ebiten.Image, data []byte) (*SpriteSheet, error) {
+	var export asepriteExport
+	if err := json.Unmarshal(data, &export); err != nil {
+		return nil, fmt.Errorf("aseprite: %w", err)
+	}
+
+	frames, err := parseAsepriteFrames(export.Frames)
+	if err != nil {
+		return nil, err
+	}
+
+	sheet := NewSpriteSheet(img, nil)
+	for _, f := range frames {
+		sheet.Frames = append(sheet.Frames, image.Rect(f.Frame.X, f.Frame.Y, f.Frame.X+f.Frame.W, f.Frame.Y+f.Frame.H))
+		sheet.Durations = append(sheet.Durations, msToTicks(f.Duration))
+	}
+
+	for _, tag := range export.Meta.FrameTags {
+		if tag.From < 0 || tag.To >= len(sheet.Frames) || tag.From > tag.To {
+			return nil, fmt.Errorf("aseprite: tag %q is out of range", tag.Name)
+		}
+		sheet.Tags[strings.ToLower(tag.Name)] = FrameTag{From: tag.From, To: tag.To}
+	}
+
+	return sheet, nil
+}
+
+func parseAsepriteFrames(raw json.RawMessage) ([]asepriteFrame, error) {
+	raw = bytes.TrimSpace(raw)
+	if len(raw) == 0 {
+		return nil, fmt.Errorf("aseprite: no frames")
+	}
+
+	if raw[0] == '[' {
+		var frames []asepriteFrame
+		if err := json.Unmarshal(raw, &frames); err != nil {
+			return nil, fmt.Errorf("aseprite: %w", err)
+		}
+		return frames, nil
+	}
+
+	// hash layout: keys are file names, order in the file is the frame order
+	dec := json.NewDecoder(bytes.NewReader(raw))
+	if _, err := dec.Token(); err != nil {
+		return nil, fmt.Errorf("aseprite: %w", err)
+	}
+
+	var frames []asepriteFrame
+	for dec.More() {
+		key, err := dec.Token()
+		if err != nil {
+			return nil, fmt.Errorf("aseprite: %w", err)
+		}
+
+		var f asepriteFrame
+		if err := dec.Decode(&f); err != nil {
+			return nil, fmt.Errorf("aseprite: %w", err)
+		}
+		f.Filename, _ = key.(string)
+		frames = append(frames, f)
+	}
+
+	return frames, nil
+}
+
+func msToTicks(ms int) int {
+	if ms <= 0 {
+		return 0
+	}
+	return max(1, (ms*60+500)/1000)
+}
+
+func (s *SpriteSheet) Frame(i int) *ebiten.Image {
+	if s.frameCache == nil {
+		s.frameCache = make([]*ebiten.Image, len(s.Frames))
+	}
+	if s.frameCache[i] == nil {
+		s.frameCache[i] = s.Image.SubImage(s.Frames[i]).(*ebiten.Image)
+	}
+	return s.frameCache[i]
+}
+
+// Clip builds an animation clip from a tag. Frames without a duration fall
+// back to DefaultFrameTicks.
+func (s *SpriteSheet) Clip(tag string, loop bool) (components.AnimationClip, error) {
+	t, ok := s.Tags[strings.ToLower(tag)]
+	if !ok {
+		return components.AnimationClip{}, fmt.Errorf("sprite sheet: unknown tag %q", tag)
+	}
+
+	clip := components.AnimationClip{Loop: loop}
+	for i := t.From; i <= t.To; i++ {
+		ticks := s.Durations[i]
+		if ticks <= 0 {
+			ticks = DefaultFrameTicks
+		}
+
+		clip.Frames = append(clip.Frames, s.Frame(i))
+		clip.FrameTicks = append(clip.FrameTicks, ticks)
+	}
+
+	return clip, nil
+}
@@ -0,0 +1,197 @@
+package assets
+
+import (
+	"image/color"
+
+	"github.com/hajimehoshi/ebiten/v2"
+	"github.com/solarlune/resolv"
+
+	"github.com/game-jam-2026/dead-jump/internal/ecs"
+	"github.com/game-jam-2026/dead-jump/internal/ecs/components"
+	"github.com/game-jam-2026/dead-jump/pkg/linalg"
+)
+
+const (
+	doorWidth  = 8
+	doorHeight = 48
+)
+
+var (
+	plateImage  *ebiten.Image
+	leverImage  *ebiten.Image
+	buttonImage *ebiten.Image
+	doorImage   *ebiten.Image
+	gateImage   *ebiten.Image
+)
+
+// CreatePressurePlate drives output while the character, a corpse or a
+// settled projectile rests on it.
+func CreatePressurePlate(w *ecs.World, x, y float64, output string) ecs.EntityID {
+	img := switchImage(&plateImage, 16, 4, color.RGBA{150, 120, 60, 255})
+	entity := createSwitch(w, x, y, img)
+	w.SetComponent(entity, components.PressurePlate{Output: output})
+	return entity
+}
+
+// CreateLever toggles output whenever the character walks into it.
+func CreateLever(w *ecs.World, x, y float64, output string) ecs.EntityID {
+	entity := createSwitch(w, x, y, leverSprite())
+	w.SetComponent(entity, components.Lever{Output: output})
+	return entity
+}
+
+// CreateTimedButton drives output for duration ticks after a touch.
+func CreateTimedButton(w *ecs.World, x, y float64, output string, duration int) ecs.EntityID {
+	img := switchImage(&buttonImage, 8, 6, color.RGBA{200, 50, 50, 255})
+	entity := createSwitch(w, x, y, img)
+	w.SetComponent(entity, components.TimedButton{Output: output, Duration: duration})
+	return entity
+}
+
+func createSwitch(w *ecs.World, x, y float64, img *ebiten.Image) ecs.EntityID {
+	entity := w.CreateEntity()
+
+	bounds := img.Bounds()
+	w.SetComponent(entity, components.Position{
+		Vector: linalg.Vector2{X: x, Y: y},
+	})
+	w.SetComponent(entity, components.Sprite{Image: img, ZIndex: 1})
+	w.SetComponent(entity, components.Collision{
+		Shape: resolv.NewRectangleFromTopLeft(x, y, float64(bounds.Dx()), float64(bounds.Dy())),
+	})
+	w.SetComponent(entity, components.StaticBody())
+	w.SetComponent(entity, components.Sensor{})
+
+	return entity
+}
+
+// CreateLogicGate combines input channels into output; duration is only
+// used by LogicTimer.
+func CreateLogicGate(w *ecs.World, op components.LogicOp, output string, duration int, inputs ...string) ecs.EntityID {
+	entity := w.CreateEntity()
+	w.SetComponent(entity, components.LogicGate{
+		Op:       op,
+		Inputs:   inputs,
+		Output:   output,
+		Duration: duration,
+	})
+	return entity
+}
+
+// Wire makes an existing entity follow input: cannons fire, spikes retract,
+// doors open and moving platforms move while it is on (off with invert).
+func Wire(w *ecs.World, entity ecs.EntityID, input string, invert bool) {
+	w.SetComponent(entity, components.SignalReceiver{Input: input, Invert: invert})
+}
+
+// CreateDoor creates a vertical door that slides up while input is on.
+func CreateDoor(w *ecs.World, x, y float64, input string) ecs.EntityID {
+	img := barsImage(&doorImage, doorWidth, doorHeight)
+	return createSlidingBlock(w, x, y, img, linalg.Vector2{Y: -doorHeight + 4}, input)
+}
+
+// CreateGate creates a horizontal gate that slides aside while input is on.
+func CreateGate(w *ecs.World, x, y float64, input string) ecs.EntityID {
+	img := barsImage(&gateImage, doorHeight, doorWidth)
+	return createSlidingBlock(w, x, y, img, linalg.Vector2{X: -doorHeight + 4}, input)
+}
+
+func createSlidingBlock(w *ecs.World, x, y float64, img *ebiten.Image, offset linalg.Vector2, input string) ecs.EntityID {
+	entity := w.CreateEntity()
+
+	bounds := img.Bounds()
+	w.SetComponent(entity, components.Position{
+		Vector: linalg.Vector2{X: x, Y: y},
+	})
+	w.SetComponent(entity, components.Sprite{Image: img, ZIndex: 2})
+	w.SetComponent(entity, components.Collision{
+		Shape: resolv.NewRectangleFromTopLeft(x, y, float64(bounds.Dx()), float64(bounds.Dy())),
+	})
+	w.SetComponent(entity, components.StaticBody())
+	w.SetComponent(entity, components.Door{
+		Closed:     linalg.Vector2{X: x, Y: y},
+		OpenOffset: offset,
+		Speed:      0.05,
+	})
+	Wire(w, entity, input, false)
+
+	return entity
+}
+
+// CreateMovingPlatform creates a platform travelling between points (their
+// centers); with a non-empty input it only moves while the signal is on.
+func CreateMovingPlatform(w *ecs.World, x, y float64, input string, points ...linalg.Vector2) ecs.EntityID {
+	entity := CreatePlatform(w, x, y, 32, 32, components.Repeatable{Direction: linalg.Vector2{X: 1}, Count: 1})
+	w.SetComponent(entity, components.MovingPlatform{})
+	w.SetComponent(entity, components.Patrol{Points: points, Speed: 0.5})
+	if input != "" {
+		Wire(w, entity, input, false)
+	}
+	return entity
+}
+
+func switchImage(cache **ebiten.Image, width, height int, c color.RGBA) *ebiten.Image {
+	if *cache != nil {
+		return *cache
+	}
+
+	img := ebiten.NewImage(width, height)
+	img.Fill(c)
+	top := color.RGBA{lighten(c.R), lighten(c.G), lighten(c.B), 255}
+	for i := 0; i < width; i++ {
+		img.Set(i, 0, top)
+	}
+
+	*cache = img
+	return img
+}
+
+func lighten(v uint8) uint8 {
+	return uint8(min(int(v)+60, 255))
+}
+
+func leverSprite() *ebiten.Image {
+	if leverImage != nil {
+		return leverImage
+	}
+
+	rows := []string{
+		".......o",
+		"......#.",
+		".....#..",
+		"....#...",
+		"...#....",
+		"..bbbb..",
+		".bbbbbb.",
+	}
+	leverImage = pixelImage(rows, map[byte]color.RGBA{
+		'o': {220, 60, 60, 255},
+		'#': {150, 150, 150, 255},
+		'b': {90, 70, 50, 255},
+	})
+	return leverImage
+}
+
+func barsImage(cache **ebiten.Image, width, height int) *ebiten.Image {
+	if *cache != nil {
+		return *cache
+	}
+
+	img := ebiten.NewImage(width, height)
+	img.Fill(color.RGBA{50, 45, 40, 255})
+	for i := 0; i < width; i++ {
+		for j := 0; j < height; j++ {
+			// прутья решётки поперёк длинной стороны
+			along := j
+			if width > height {
+				along = i
+			}
+			if along%6 < 2 {
+				img.Set(i, j, color.RGBA{120, 110, 100, 255})
+			}
+		}
+	}
+
+	*cache = img
+	return img
+}
@@ -0,0 +1,182 @@
+package assets
+
+import (
+	"encoding/binary"
+	"math"
+	"math/rand"
+)
+
+const sampleRate = 44100
+
+// synthChargeSound is a rising tone with a tremolo for the cannon wind-up.
+// There's no recorded sample for it, so it is generated as 16-bit stereo PCM.
+func synthChargeSound() []byte {
+	const (
+		duration  = 0.45
+		startFreq = 180.0
+		endFreq   = 720.0
+		volume    = 0.25
+	)
+
+	n := int(duration * sampleRate)
+	buf := make([]byte, n*4)
+
+	phase := 0.0
+	for i := 0; i < n; i++ {
+		t := float64(i) / float64(n)
+		freq := startFreq + (endFreq-startFreq)*t*t
+		phase += 2 * math.Pi * freq / sampleRate
+
+		// square-ish wave, softened so it isn't too harsh
+		s := math.Tanh(3 * math.Sin(phase))
+		tremolo := 0.6 + 0.4*math.Sin(2*math.Pi*18*t*duration)
+		envelope := math.Min(1, t*10) * math.Min(1, (1-t)*20)
+
+		v := int16(s * tremolo * envelope * volume * math.MaxInt16)
+		binary.LittleEndian.PutUint16(buf[i*4:], uint16(v))
+		binary.LittleEndian.PutUint16(buf[i*4+2:], uint16(v))
+	}
+
+	return buf
+}
+
+// synthPickupSound is a quick two-note chime for collectibles.
+func synthPickupSound() []byte {
+	const (
+		noteDuration = 0.07
+		volume       = 0.2
+	)
+	notes := []float64{880, 1320}
+
+	n := int(noteDuration * sampleRate)
+	buf := make([]byte, len(notes)*n*4)
+
+	phase := 0.0
+	for k, freq := range notes {
+		for i := 0; i < n; i++ {
+			t := float64(i) / float64(n)
+			phase += 2 * math.Pi * freq / sampleRate
+
+			envelope := math.Min(1, t*20) * (1 - t)
+			v := int16(math.Sin(phase) * envelope * volume * math.MaxInt16)
+
+			offset := (k*n + i) * 4
+			binary.LittleEndian.PutUint16(buf[offset:], uint16(v))
+			binary.LittleEndian.PutUint16(buf[offset+2:], uint16(v))
+		}
+	}
+
+	return buf
+}
+
+// synthCrushSound is a dull thump: a falling low tone under a burst of noise.
+func synthCrushSound() []byte {
+	const (
+		duration = 0.3
+		volume   = 0.35
+	)
+	noise := rand.New(rand.NewSource(1))
+
+	n := int(duration * sampleRate)
+	buf := make([]byte, n*4)
+
+	phase := 0.0
+	for i := 0; i < n; i++ {
+		t := float64(i) / float64(n)
+		phase += 2 * math.Pi * (90 - 50*t) / sampleRate
+
+		envelope := math.Exp(-6 * t)
+		s := 0.7*math.Sin(phase) + 0.3*(noise.Float64()*2-1)*math.Exp(-20*t)
+
+		v := int16(s * envelope * volume * math.MaxInt16)
+		binary.LittleEndian.PutUint16(buf[i*4:], uint16(v))
+		binary.LittleEndian.PutUint16(buf[i*4+2:], uint16(v))
+	}
+
+	return buf
+}
+
+// synthBurnSound is a sizzle: smoothed noise that swells and dies out.
+func synthBurnSound() []byte {
+	const (
+		duration = 0.6
+		volume   = 0.2
+	)
+	noise := rand.New(rand.NewSource(2))
+
+	n := int(duration * sampleRate)
+	buf := make([]byte, n*4)
+
+	smooth := 0.0
+	for i := 0; i < n; i++ {
+		t := float64(i) / float64(n)
+		smooth += 0.35 * (noise.Float64()*2 - 1 - smooth)
+		crackle := 1.0
+		if noise.Float64() < 0.002 {
+			crackle = 3
+		}
+		envelope := math.Min(1, t*8) * (1 - t)
+
+		v := int16(math.Max(-1, math.Min(1, smooth*crackle)) * envelope * volume * math.MaxInt16)
+		binary.LittleEndian.PutUint16(buf[i*4:], uint16(v))
+		binary.LittleEndian.PutUint16(buf[i*4+2:], uint16(v))
+	}
+
+	return buf
+}
+
+// synthFallSound is a whistle sliding down as the body drops away.
+func synthFallSound() []byte {
+	const (
+		duration  = 0.7
+		startFreq = 1200.0
+		endFreq   = 300.0
+		volume    = 0.18
+	)
+
+	n := int(duration * sampleRate)
+	buf := make([]byte, n*4)
+
+	phase := 0.0
+	for i := 0; i < n; i++ {
+		t := float64(i) / float64(n)
+		phase += 2 * math.Pi * (startFreq + (endFreq-startFreq)*t) / sampleRate
+
+		envelope := math.Min(1, t*20) * (1 - t)
+		v := int16(math.Sin(phase) * envelope * volume * math.MaxInt16)
+		binary.LittleEndian.PutUint16(buf[i*4:], uint16(v))
+		binary.LittleEndian.PutUint16(buf[i*4+2:], uint16(v))
+	}
+
+	return buf
+}
+
+// synthSacrificeSound is a soft descending three-note motif.
+func synthSacrificeSound() []byte {
+	const (
+		noteDuration = 0.09
+		volume       = 0.2
+	)
+	notes := []float64{660, 523, 392}
+
+	n := int(noteDuration * sampleRate)
+	buf := make([]byte, len(notes)*n*4)
+
+	phase := 0.0
+	for k, freq := range notes {
+		for i := 0; i < n; i++ {
+			t := float64(i) / float64(n)
+			phase += 2 * math.Pi * freq / sampleRate
+
+			envelope := math.Min(1, t*20) * (1 - 0.6*t)
+			s := math.Tanh(2 * math.Sin(phase))
+			v := int16(s * envelope * volume * math.MaxInt16)
+
+			offset := (k*n + i) * 4
+			binary.LittleEndian.PutUint16(buf[offset:], uint16(v))
+			binary.LittleEndian.PutUint16(buf[offset+2:], uint16(v))
+		}
+	}
+
+	return buf
+}
@@ -3,13 +3,14 @@ package assets
 import (
 	"github.com/game-jam-2026/dead-jump/internal/ecs"
 	"github.com/game-jam-2026/dead-jump/internal/ecs/components"
+	"github.com/game-jam-2026/dead-jump/internal/game"
 	"github.com/game-jam-2026/dead-jump/internal/physics"
 	"github.com/game-jam-2026/dead-jump/pkg/linalg"
 )
 
 const TowerLevelHeight = 600
 
-func LoadTowerLevel() *ecs.World {
+func LoadTowerLevel(p game.Profile) *ecs.World {
 	w := ecs.NewWorld()
 	CreateAudioManager(w)
 	CreateLifeCounter(w, 3)
@@ -17,6 +18,9 @@ func LoadTowerLevel() *ecs.World {
 
 	playerID := CreateCharacter(w, 40, 530, 1)
 
+	CreateForestBackdrop(w)
+	CreateMoonLayer(w, 240, 20)
+
 	CreateSpike(w, 0, 584, components.Repeatable{
 		Direction: linalg.Vector2{X: 1},
 		Count:     20,
@@ -77,6 +81,16 @@ func LoadTowerLevel() *ecs.World {
 	CreateTombstone2(w, 204, 440)
 	CreateTombstone3(w, 24, 380)
 
+	CreateFruit(w, 268, 470)
+
+	// тайник справа: стена из тайлов прячет осколок души
+	CreatePlatform(w, 296, 470, 24, 16, components.Repeatable{
+		Direction: linalg.Vector2{X: 1},
+		Count:     1,
+	})
+	CreateSoulShard(w, 304, 458)
+	CreateHiddenWall(w, 288, 438, 2, 2, Image(ImageTileGroundTextured))
+
 	CreateLevelFinish(w, 274, 354)
 
 	camera := components.NewCamera(320, 240)
@@ -87,7 +101,14 @@ func LoadTowerLevel() *ecs.World {
 	camera.MaxY = TowerLevelHeight
 	w.SetResource(camera)
 
+	// из башни падают в никуда: тела не остаётся
+	bounds := components.NewLevelBounds(320, TowerLevelHeight)
+	bounds.NoFallCorpse = true
+	w.SetResource(bounds)
+
 	w.SetResource(*physics.DefaultConfig())
 
+	ApplyProfile(w, p)
+
 	return w
 }
@@ -0,0 +1,446 @@
+package console
+
+import (
+	"fmt"
+	"math"
+	"reflect"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/hajimehoshi/ebiten/v2"
+	"github.com/solarlune/resolv"
+
+	"github.com/game-jam-2026/dead-jump/internal/assets"
+	"github.com/game-jam-2026/dead-jump/internal/ecs"
+	"github.com/game-jam-2026/dead-jump/internal/ecs/components"
+	"github.com/game-jam-2026/dead-jump/internal/ecs/systems"
+	"github.com/game-jam-2026/dead-jump/internal/physics"
+	"github.com/game-jam-2026/dead-jump/internal/snapshot"
+	"github.com/game-jam-2026/dead-jump/pkg/linalg"
+)
+
+const DefaultSnapshotPath = "quicksave.json"
+
+type command struct {
+	usage string
+	run   func(c *Console, w *ecs.World, args []string) error
+}
+
+var commands map[string]command
+
+func init() {
+	commands = map[string]command{
+		"help":  {"help", cmdHelp},
+		"ls":    {"ls <Component>... - list entities", cmdList},
+		"dump":  {"dump <id> - print components", cmdDump},
+		"set":   {"set <id> <Component.Field> <value>", cmdSet},
+		"tp":    {"tp <x> <y> - teleport player", cmdTeleport},
+		"lives": {"lives <n>", cmdLives},
+		"god":   {"god - toggle invulnerability", cmdGod},
+		"spawn": {"spawn cannon [deg] [type]|corpse|fruit|shard|enemy <kind>|hazard <kind> - at cursor", cmdSpawn},
+		"level": {"level <index> - jump to level", cmdLevel},
+		"phys":  {"phys [<Field> <value>] - physics config", cmdPhysics},
+		"save":  {"save [file] - write a world snapshot", cmdSave},
+		"load":  {"load [file] - restore a world snapshot", cmdLoad},
+		"demo":  {"demo record|stop [file] - record an attract mode demo", cmdDemo},
+	}
+}
+
+// Exec runs a single console command line.
+func (c *Console) Exec(line string) {
+	args := strings.Fields(line)
+	if len(args) == 0 {
+		return
+	}
+
+	cmd, ok := commands[args[0]]
+	if !ok {
+		c.Printf("unknown command %q, try help", args[0])
+		return
+	}
+
+	w := c.hooks.World()
+	if w == nil && args[0] != "help" && args[0] != "level" && args[0] != "load" {
+		c.Printf("no level loaded")
+		return
+	}
+
+	if err := cmd.run(c, w, args[1:]); err != nil {
+		c.Printf("%s: %v", args[0], err)
+	}
+}
+
+func cmdHelp(c *Console, _ *ecs.World, _ []string) error {
+	names := make([]string, 0, len(commands))
+	for name := range commands {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		c.Printf("  %s", commands[name].usage)
+	}
+	return nil
+}
+
+func cmdList(c *Console, w *ecs.World, args []string) error {
+	if len(args) == 0 {
+		names := make([]string, 0, len(w.Entities))
+		for t, entities := range w.Entities {
+			names = append(names, fmt.Sprintf("%s(%d)", t.Name(), len(entities)))
+		}
+		sort.Strings(names)
+		c.Printf("%s", strings.Join(names, " "))
+		return nil
+	}
+
+	types := make([]reflect.Type, 0, len(args))
+	for _, name := range args {
+		t, ok := componentType(w, name)
+		if !ok {
+			return fmt.Errorf("unknown component %q", name)
+		}
+		types = append(types, t)
+	}
+
+	entities := w.GetEntities(types...)
+	sort.Slice(entities, func(i, j int) bool { return entities[i] < entities[j] })
+
+	ids := make([]string, len(entities))
+	for i, e := range entities {
+		ids[i] = strconv.FormatInt(int64(e), 10)
+	}
+	c.Printf("%d: %s", len(entities), strings.Join(ids, " "))
+	return nil
+}
+
+func cmdDump(c *Console, w *ecs.World, args []string) error {
+	entity, err := parseEntity(args)
+	if err != nil {
+		return err
+	}
+
+	types := componentTypes(w, entity)
+	if len(types) == 0 {
+		return fmt.Errorf("entity %d has no components", entity)
+	}
+
+	for _, t := range types {
+		c.Printf("%s %s", t.Name(), formatValue(w.Entities[t][entity]))
+	}
+	return nil
+}
+
+func cmdSet(c *Console, w *ecs.World, args []string) error {
+	if len(args) != 3 {
+		return fmt.Errorf("usage: %s", commands["set"].usage)
+	}
+
+	entity, err := parseEntity(args)
+	if err != nil {
+		return err
+	}
+
+	typeName, path, _ := strings.Cut(args[1], ".")
+	t, ok := componentType(w, typeName)
+	if !ok {
+		return fmt.Errorf("unknown component %q", typeName)
+	}
+
+	stored, ok := w.Entities[t][entity]
+	if !ok {
+		return fmt.Errorf("entity %d has no %s", entity, t.Name())
+	}
+	if path == "" {
+		return fmt.Errorf("missing field name")
+	}
+
+	updated, err := setField(stored, path, args[2])
+	if err != nil {
+		return err
+	}
+	w.Entities[t][entity] = updated
+
+	c.Printf("%s %s", t.Name(), formatValue(updated))
+	return nil
+}
+
+func cmdTeleport(c *Console, w *ecs.World, args []string) error {
+	if len(args) != 2 {
+		return fmt.Errorf("usage: %s", commands["tp"].usage)
+	}
+
+	x, errX := strconv.ParseFloat(args[0], 64)
+	y, errY := strconv.ParseFloat(args[1], 64)
+	if errX != nil || errY != nil {
+		return fmt.Errorf("bad coordinates")
+	}
+
+	player, ok := findPlayer(w)
+	if !ok {
+		return fmt.Errorf("no player")
+	}
+
+	w.SetComponent(player, components.Position{Vector: linalg.Vector2{X: x, Y: y}})
+	w.SetComponent(player, components.Velocity{Vector: linalg.Zero()})
+
+	c.Printf("player %d at %.0f,%.0f", player, x, y)
+	return nil
+}
+
+func cmdLives(c *Console, w *ecs.World, args []string) error {
+	if len(args) != 1 {
+		return fmt.Errorf("usage: %s", commands["lives"].usage)
+	}
+
+	n, err := strconv.Atoi(args[0])
+	if err != nil {
+		return err
+	}
+
+	counters := w.GetEntities(reflect.TypeOf((*components.Life)(nil)).Elem())
+	if len(counters) == 0 {
+		return fmt.Errorf("level has no life counter")
+	}
+
+	life, err := ecs.GetComponent[components.Life](w, counters[0])
+	if err != nil {
+		return err
+	}
+	life.Count = n
+	w.SetComponent(counters[0], *life)
+
+	c.Printf("lives %d", n)
+	return nil
+}
+
+func cmdGod(c *Console, w *ecs.World, _ []string) error {
+	player, ok := findPlayer(w)
+	if !ok {
+		return fmt.Errorf("no player")
+	}
+
+	if _, err := ecs.GetComponent[components.Invulnerable](w, player); err == nil {
+		_ = w.RemoveComponent(player, components.Invulnerable{})
+		c.Printf("god mode off")
+		return nil
+	}
+
+	w.SetComponent(player, components.Invulnerable{})
+	c.Printf("god mode on")
+	return nil
+}
+
+func cmdSpawn(c *Console, w *ecs.World, args []string) error {
+	if len(args) == 0 {
+		return fmt.Errorf("usage: %s", commands["spawn"].usage)
+	}
+
+	pos := cursorWorldPosition(w)
+
+	var entity ecs.EntityID
+	switch args[0] {
+	case "cannon":
+		cfg := assets.DefaultCannonConfig()
+		if len(args) > 1 {
+			deg, err := strconv.ParseFloat(args[1], 64)
+			if err != nil {
+				return err
+			}
+			cfg.Direction = deg * math.Pi / 180
+		}
+		if len(args) > 2 {
+			if !slices.Contains(systems.ProjectileTypeNames(), args[2]) {
+				return fmt.Errorf("unknown projectile type %q, have %v", args[2], systems.ProjectileTypeNames())
+			}
+			cfg.ProjectileType = args[2]
+		}
+		entity = assets.CreateCannon(w, pos.X, pos.Y, cfg)
+	case "enemy":
+		if len(args) < 2 {
+			return fmt.Errorf("usage: spawn enemy %s|%s|%s|%s|%s", components.MachineWalker, components.MachinePatrol, components.MachineGuard, components.MachineFlyer, components.MachineBat)
+		}
+		switch args[1] {
+		case components.MachineWalker:
+			entity = assets.CreateWalker(w, pos.X, pos.Y)
+		case components.MachinePatrol:
+			entity = assets.CreatePatroller(w, pos.X, pos.Y, pos, pos.Add(linalg.Vector2{X: 64}))
+		case components.MachineGuard:
+			entity = assets.CreateGuard(w, pos.X, pos.Y, pos.X+64)
+		case components.MachineFlyer:
+			entity = assets.CreateFlyer(w, pos.X, pos.Y)
+		case components.MachineBat:
+			entity = assets.CreateBat(w, pos.X, pos.Y)
+		default:
+			return fmt.Errorf("unknown enemy %q", args[1])
+		}
+	case "hazard":
+		if len(args) < 2 {
+			return fmt.Errorf("usage: spawn hazard saw|block|lava|acid|crusher")
+		}
+		switch args[1] {
+		case "saw":
+			entity = assets.CreateSaw(w, pos.X, pos.Y)
+		case "block":
+			entity = assets.CreateFallingBlock(w, pos.X, pos.Y)
+		case "lava":
+			entity = assets.CreateLava(w, pos.X, pos.Y, 64, 16)
+		case "acid":
+			entity = assets.CreateAcid(w, pos.X, pos.Y, 64, 16)
+		case "crusher":
+			entity = assets.CreateCrusher(w, pos.X, pos.Y, pos.Y+64)
+		default:
+			return fmt.Errorf("unknown hazard %q", args[1])
+		}
+	case "fruit":
+		entity = assets.CreateFruit(w, pos.X, pos.Y)
+	case "shard":
+		entity = assets.CreateSoulShard(w, pos.X, pos.Y)
+	case "corpse":
+		entity = assets.CreateCorpse(w, pos.X, pos.Y, 1)
+		if sprite, err := ecs.GetComponent[components.Sprite](w, entity); err == nil {
+			width, height := sprite.Size()
+			w.SetComponent(entity, components.Collision{
+				Shape: resolv.NewRectangleFromTopLeft(pos.X, pos.Y, width, height),
+			})
+		}
+	default:
+		return fmt.Errorf("can't spawn %q", args[0])
+	}
+
+	c.Printf("spawned %s %d at %.0f,%.0f", args[0], entity, pos.X, pos.Y)
+	return nil
+}
+
+func cmdLevel(c *Console, _ *ecs.World, args []string) error {
+	if len(args) != 1 {
+		return fmt.Errorf("usage: %s", commands["level"].usage)
+	}
+
+	index, err := strconv.Atoi(args[0])
+	if err != nil {
+		return err
+	}
+
+	w, err := c.hooks.JumpLevel(index)
+	if err != nil {
+		return err
+	}
+	c.hooks.SetWorld(w)
+
+	c.Printf("level %d", index)
+	return nil
+}
+
+func cmdDemo(c *Console, _ *ecs.World, args []string) error {
+	if len(args) == 0 {
+		return fmt.Errorf("usage: %s", commands["demo"].usage)
+	}
+
+	switch args[0] {
+	case "record":
+		level, err := c.hooks.RecordDemo()
+		if err != nil {
+			return err
+		}
+		c.Printf("recording %s from the start, demo stop to finish", level)
+	case "stop":
+		path := ""
+		if len(args) > 1 {
+			path = args[1]
+		}
+		path, err := c.hooks.StopDemo(path)
+		if err != nil {
+			return err
+		}
+		c.Printf("wrote %s", path)
+	default:
+		return fmt.Errorf("usage: %s", commands["demo"].usage)
+	}
+	return nil
+}
+
+func cmdPhysics(c *Console, w *ecs.World, args []string) error {
+	cfg, err := ecs.GetResource[physics.Config](w)
+	if err != nil {
+		return err
+	}
+
+	switch len(args) {
+	case 0:
+		c.Printf("%s", formatValue(*cfg))
+		return nil
+	case 2:
+	default:
+		return fmt.Errorf("usage: %s", commands["phys"].usage)
+	}
+
+	updated, err := setField(*cfg, args[0], args[1])
+	if err != nil {
+		return err
+	}
+	w.SetResource(updated)
+
+	c.Printf("%s = %s", args[0], args[1])
+	return nil
+}
+
+func cmdSave(c *Console, w *ecs.World, args []string) error {
+	path := snapshotPath(args)
+	if err := snapshot.WriteFile(w, path); err != nil {
+		return err
+	}
+
+	c.Printf("saved %s", path)
+	return nil
+}
+
+func cmdLoad(c *Console, _ *ecs.World, args []string) error {
+	path := snapshotPath(args)
+	w, err := snapshot.ReadFile(path)
+	if err != nil {
+		return err
+	}
+	c.hooks.SetWorld(w)
+
+	c.Printf("loaded %s", path)
+	return nil
+}
+
+func snapshotPath(args []string) string {
+	if len(args) > 0 {
+		return args[0]
+	}
+	return DefaultSnapshotPath
+}
+
+func parseEntity(args []string) (ecs.EntityID, error) {
+	if len(args) == 0 {
+		return 0, fmt.Errorf("missing entity id")
+	}
+	id, err := strconv.ParseInt(args[0], 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("bad entity id %q", args[0])
+	}
+	return ecs.EntityID(id), nil
+}
+
+func findPlayer(w *ecs.World) (ecs.EntityID, bool) {
+	entities := w.GetEntities(reflect.TypeOf((*components.Character)(nil)).Elem())
+	if len(entities) == 0 {
+		return 0, false
+	}
+	return entities[0], true
+}
+
+func cursorWorldPosition(w *ecs.World) linalg.Vector2 {
+	cx, cy := ebiten.CursorPosition()
+	pos := linalg.Vector2{X: float64(cx), Y: float64(cy)}
+
+	if camera, err := ecs.GetResource[components.Camera](w); err == nil {
+		pos = pos.Add(camera.Position)
+	}
+	return pos
+}
@@ -0,0 +1,131 @@
+package console
+
+import (
+	"fmt"
+	"image/color"
+	"strings"
+
+	"github.com/hajimehoshi/ebiten/v2"
+	"github.com/hajimehoshi/ebiten/v2/ebitenutil"
+	"github.com/hajimehoshi/ebiten/v2/inpututil"
+	"github.com/hajimehoshi/ebiten/v2/vector"
+
+	"github.com/game-jam-2026/dead-jump/internal/ecs"
+)
+
+const (
+	ToggleKey = ebiten.KeyBackquote
+
+	maxLines     = 64
+	visibleLines = 8
+	lineHeight   = 14
+	charWidth    = 6
+	screenWidth  = 320
+)
+
+var backgroundColor = color.RGBA{0, 0, 0, 200}
+
+// Hooks connect the console to the game.
+type Hooks struct {
+	World     func() *ecs.World
+	SetWorld  func(w *ecs.World)
+	JumpLevel func(index int) (*ecs.World, error)
+	// RecordDemo restarts the level and records inputs until StopDemo
+	// writes them to path; both return the level name or file written.
+	RecordDemo func() (string, error)
+	StopDemo   func(path string) (string, error)
+}
+
+type Console struct {
+	hooks Hooks
+
+	open    bool
+	input   []rune
+	lines   []string
+	history []string
+	recall  int
+}
+
+func New(hooks Hooks) *Console {
+	return &Console{hooks: hooks}
+}
+
+func (c *Console) IsOpen() bool {
+	return c.open
+}
+
+// Update handles console input. It returns true while the console is open and
+// the game should not receive input.
+func (c *Console) Update() bool {
+	if inpututil.IsKeyJustPressed(ToggleKey) {
+		c.open = !c.open
+		return true
+	}
+	if !c.open {
+		return false
+	}
+
+	for _, r := range ebiten.AppendInputChars(nil) {
+		if r != '`' {
+			c.input = append(c.input, r)
+		}
+	}
+
+	switch {
+	case inpututil.IsKeyJustPressed(ebiten.KeyEnter):
+		line := strings.TrimSpace(string(c.input))
+		c.input = c.input[:0]
+		if line != "" {
+			c.history = append(c.history, line)
+			c.recall = len(c.history)
+			c.Printf("> %s", line)
+			c.Exec(line)
+		}
+	case inpututil.IsKeyJustPressed(ebiten.KeyBackspace) && len(c.input) > 0:
+		c.input = c.input[:len(c.input)-1]
+	case inpututil.IsKeyJustPressed(ebiten.KeyUp) && c.recall > 0:
+		c.recall--
+		c.input = []rune(c.history[c.recall])
+	case inpututil.IsKeyJustPressed(ebiten.KeyDown) && c.recall < len(c.history):
+		c.recall++
+		c.input = c.input[:0]
+		if c.recall < len(c.history) {
+			c.input = []rune(c.history[c.recall])
+		}
+	case inpututil.IsKeyJustPressed(ebiten.KeyEscape):
+		c.open = false
+	}
+
+	return true
+}
+
+// Printf appends output, wrapping it to the screen width.
+func (c *Console) Printf(format string, args ...any) {
+	width := screenWidth/charWidth - 1
+	for _, line := range strings.Split(fmt.Sprintf(format, args...), "\n") {
+		for len(line) > width {
+			c.lines = append(c.lines, line[:width])
+			line = "  " + line[width:]
+		}
+		c.lines = append(c.lines, line)
+	}
+
+	if len(c.lines) > maxLines {
+		c.lines = c.lines[len(c.lines)-maxLines:]
+	}
+}
+
+func (c *Console) Draw(screen *ebiten.Image) {
+	if !c.open {
+		return
+	}
+
+	height := (visibleLines + 1) * lineHeight
+	vector.DrawFilledRect(screen, 0, 0, screenWidth, float32(height)+4, backgroundColor, false)
+
+	lines := c.lines[max(0, len(c.lines)-visibleLines):]
+	for i, line := range lines {
+		ebitenutil.DebugPrintAt(screen, line, 2, i*lineHeight)
+	}
+	ebitenutil.DebugPrintAt(screen, "] "+string(c.input)+"_", 2, visibleLines*lineHeight)
+}
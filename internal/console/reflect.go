@@ -0,0 +1,150 @@
+package console
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/game-jam-2026/dead-jump/internal/ecs"
+)
+
+// componentType finds a registered component type by its name, ignoring case.
+func componentType(w *ecs.World, name string) (reflect.Type, bool) {
+	for t := range w.Entities {
+		if strings.EqualFold(t.Name(), name) {
+			return t, true
+		}
+	}
+	return nil, false
+}
+
+func componentTypes(w *ecs.World, entity ecs.EntityID) []reflect.Type {
+	var types []reflect.Type
+	for t, entities := range w.Entities {
+		if _, ok := entities[entity]; ok {
+			types = append(types, t)
+		}
+	}
+	sort.Slice(types, func(i, j int) bool {
+		return types[i].Name() < types[j].Name()
+	})
+	return types
+}
+
+// setPath writes raw into the field addressed by a dotted path, e.g.
+// "Vector.X". Components are stored by value, so the caller passes a copy
+// and stores it back.
+func setPath(v reflect.Value, path string, raw string) error {
+	for _, name := range strings.Split(path, ".") {
+		if v.Kind() == reflect.Pointer {
+			if v.IsNil() {
+				return fmt.Errorf("%s: nil pointer", name)
+			}
+			v = v.Elem()
+		}
+		if v.Kind() != reflect.Struct {
+			return fmt.Errorf("%s: not a struct", name)
+		}
+
+		field, ok := fieldByName(v, name)
+		if !ok {
+			return fmt.Errorf("no field %q in %s", name, v.Type())
+		}
+		v = field
+	}
+
+	if !v.CanSet() {
+		return fmt.Errorf("%s is not settable", path)
+	}
+	return setValue(v, raw)
+}
+
+func fieldByName(v reflect.Value, name string) (reflect.Value, bool) {
+	t := v.Type()
+	for i := 0; i < t.NumField(); i++ {
+		if strings.EqualFold(t.Field(i).Name, name) {
+			return v.Field(i), true
+		}
+	}
+	return reflect.Value{}, false
+}
+
+func setValue(v reflect.Value, raw string) error {
+	switch v.Kind() {
+	case reflect.Float32, reflect.Float64:
+		f, err := strconv.ParseFloat(raw, 64)
+		if err != nil {
+			return err
+		}
+		v.SetFloat(f)
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		i, err := strconv.ParseInt(raw, 10, 64)
+		if err != nil {
+			return err
+		}
+		v.SetInt(i)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		u, err := strconv.ParseUint(raw, 10, 64)
+		if err != nil {
+			return err
+		}
+		v.SetUint(u)
+	case reflect.Bool:
+		b, err := strconv.ParseBool(raw)
+		if err != nil {
+			return err
+		}
+		v.SetBool(b)
+	case reflect.String:
+		v.SetString(raw)
+	default:
+		return fmt.Errorf("can't set fields of kind %s", v.Kind())
+	}
+	return nil
+}
+
+// setField updates one field of a value stored in an interface map and returns
+// the modified copy.
+func setField(stored interface{}, path, raw string) (interface{}, error) {
+	v := reflect.New(reflect.TypeOf(stored)).Elem()
+	v.Set(reflect.ValueOf(stored))
+
+	if err := setPath(v, path, raw); err != nil {
+		return nil, err
+	}
+	return v.Interface(), nil
+}
+
+func formatValue(value interface{}) string {
+	v := reflect.ValueOf(value)
+	if v.Kind() != reflect.Struct {
+		return fmt.Sprint(value)
+	}
+
+	t := v.Type()
+	parts := make([]string, 0, t.NumField())
+	for i := 0; i < t.NumField(); i++ {
+		if !t.Field(i).IsExported() {
+			continue
+		}
+		parts = append(parts, fmt.Sprintf("%s=%s", t.Field(i).Name, formatField(v.Field(i))))
+	}
+	return "{" + strings.Join(parts, " ") + "}"
+}
+
+func formatField(v reflect.Value) string {
+	switch v.Kind() {
+	case reflect.Pointer, reflect.Interface, reflect.Map, reflect.Slice, reflect.Func:
+		if v.IsNil() {
+			return "nil"
+		}
+		return v.Type().String()
+	case reflect.Float32, reflect.Float64:
+		return strconv.FormatFloat(v.Float(), 'g', 4, 64)
+	case reflect.Struct:
+		return formatValue(v.Interface())
+	}
+	return fmt.Sprint(v.Interface())
+}
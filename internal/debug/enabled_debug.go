@@ -0,0 +1,6 @@
+//go:build debug
+
+package debug
+
+// Enabled is true in development builds (-tags debug).
+const Enabled = true
@@ -0,0 +1,112 @@
+//go:build debug
+
+package debug
+
+import (
+	"fmt"
+
+	"github.com/hajimehoshi/ebiten/v2"
+	"github.com/hajimehoshi/ebiten/v2/ebitenutil"
+	"github.com/hajimehoshi/ebiten/v2/inpututil"
+
+	"github.com/game-jam-2026/dead-jump/internal/ecs"
+)
+
+const (
+	PauseKey  = ebiten.KeyF5
+	StepKey   = ebiten.KeyF6
+	SpeedKey  = ebiten.KeyF7
+	RewindKey = ebiten.KeyF8
+
+	// rewindTicks is how much history is kept: 5 seconds at 60 TPS
+	rewindTicks = 300
+)
+
+var speeds = []float64{0.25, 0.5, 1, 2}
+
+var (
+	paused     bool
+	speedIndex = 2
+	tickBudget float64
+
+	history     []*ecs.World
+	historyHead int
+	historyLen  int
+	lastWorld   *ecs.World
+)
+
+// UpdateTimeControls handles the pause/step/speed keys and returns how many
+// simulation ticks to run this frame.
+func UpdateTimeControls() int {
+	if inpututil.IsKeyJustPressed(PauseKey) {
+		paused = !paused
+		tickBudget = 0
+	}
+	if inpututil.IsKeyJustPressed(SpeedKey) {
+		speedIndex = (speedIndex + 1) % len(speeds)
+		tickBudget = 0
+	}
+
+	if ebiten.IsKeyPressed(RewindKey) {
+		return 0
+	}
+
+	if paused {
+		if inpututil.IsKeyJustPressed(StepKey) {
+			return 1
+		}
+		return 0
+	}
+
+	tickBudget += speeds[speedIndex]
+	ticks := int(tickBudget)
+	tickBudget -= float64(ticks)
+	return ticks
+}
+
+// RecordTick stores a snapshot of w after a simulation tick. History is
+// dropped when a different world (another level) is recorded.
+func RecordTick(w *ecs.World) {
+	if history == nil {
+		history = make([]*ecs.World, rewindTicks)
+	}
+	if w != lastWorld {
+		historyHead, historyLen = 0, 0
+	}
+
+	history[historyHead] = w.Clone()
+	historyHead = (historyHead + 1) % rewindTicks
+	historyLen = min(historyLen+1, rewindTicks)
+	lastWorld = w
+}
+
+// Rewind returns the previous snapshot while the rewind key is held.
+func Rewind() (*ecs.World, bool) {
+	if !ebiten.IsKeyPressed(RewindKey) || historyLen == 0 {
+		return nil, false
+	}
+
+	historyHead = (historyHead + rewindTicks - 1) % rewindTicks
+	historyLen--
+
+	w := history[historyHead]
+	history[historyHead] = nil
+	lastWorld = w
+	return w, true
+}
+
+func DrawTimeControls(screen *ebiten.Image) {
+	var status string
+	switch {
+	case ebiten.IsKeyPressed(RewindKey):
+		status = fmt.Sprintf("REWIND %d", historyLen)
+	case paused:
+		status = "PAUSED (F6 step)"
+	case speeds[speedIndex] != 1:
+		status = fmt.Sprintf("x%g", speeds[speedIndex])
+	default:
+		return
+	}
+
+	ebitenutil.DebugPrintAt(screen, status, screen.Bounds().Dx()-len(status)*6-4, 0)
+}
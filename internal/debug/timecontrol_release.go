@@ -0,0 +1,17 @@
+//go:build !debug
+
+package debug
+
+import (
+	"github.com/hajimehoshi/ebiten/v2"
+
+	"github.com/game-jam-2026/dead-jump/internal/ecs"
+)
+
+func UpdateTimeControls() int { return 1 }
+
+func RecordTick(w *ecs.World) {}
+
+func Rewind() (*ecs.World, bool) { return nil, false }
+
+func DrawTimeControls(screen *ebiten.Image) {}
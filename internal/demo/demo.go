@@ -0,0 +1,87 @@
+// Package demo holds the recorded runs shown in attract mode. Recordings
+// are button states per tick, run-length encoded, replayed through the real
+// level loaders and systems.
+package demo
+
+import (
+	"embed"
+	"encoding/json"
+	"fmt"
+	"math/rand"
+	"path"
+
+	"github.com/game-jam-2026/dead-jump/internal/ecs/components"
+)
+
+//go:embed demos/*.json
+var files embed.FS
+
+// Demo is one recording. Inputs pairs a button mask with how many ticks it
+// was held.
+type Demo struct {
+	Level  string   `json:"level"`
+	Inputs [][2]int `json:"inputs"`
+}
+
+// Ticks expands the run-length encoded inputs.
+func (d *Demo) Ticks() []components.Button {
+	var ticks []components.Button
+	for _, run := range d.Inputs {
+		for range run[1] {
+			ticks = append(ticks, components.Button(run[0]))
+		}
+	}
+	return ticks
+}
+
+// New encodes recorded ticks of level.
+func New(level string, ticks []components.Button) *Demo {
+	d := &Demo{Level: level}
+	for _, held := range ticks {
+		last := len(d.Inputs) - 1
+		if last >= 0 && d.Inputs[last][0] == int(held) {
+			d.Inputs[last][1]++
+			continue
+		}
+		d.Inputs = append(d.Inputs, [2]int{int(held), 1})
+	}
+	return d
+}
+
+func (d *Demo) Marshal() ([]byte, error) {
+	return json.Marshal(d)
+}
+
+var bundled []*Demo
+
+// Bundled returns the demos shipped with the game.
+func Bundled() []*Demo {
+	if bundled != nil {
+		return bundled
+	}
+
+	entries, _ := files.ReadDir("demos")
+	for _, entry := range entries {
+		data, err := files.ReadFile(path.Join("demos", entry.Name()))
+		if err != nil {
+			continue
+		}
+
+		var d Demo
+		if err := json.Unmarshal(data, &d); err != nil {
+			fmt.Println("demo:", entry.Name(), err)
+			continue
+		}
+		bundled = append(bundled, &d)
+	}
+	return bundled
+}
+
+// Random picks one of the bundled demos.
+func Random() (*Demo, bool) {
+	demos := Bundled()
+	if len(demos) == 0 {
+		return nil, false
+	}
+	return demos[rand.Intn(len(demos))], true
+}
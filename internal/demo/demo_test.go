@@ -0,0 +1,58 @@
+package demo
+
+import (
+	"encoding/json"
+	"slices"
+	"testing"
+
+	"github.com/game-jam-2026/dead-jump/internal/ecs/components"
+)
+
+func TestRoundTrip(t *testing.T) {
+	right := components.ButtonRight
+	jump := components.ButtonRight | components.ButtonJump
+
+	tests := []struct {
+		name   string
+		ticks  []components.Button
+		inputs [][2]int
+	}{
+		{"empty", nil, nil},
+		{"one run", []components.Button{right, right, right}, [][2]int{{int(right), 3}}},
+		{"runs", []components.Button{0, right, right, jump, right}, [][2]int{
+			{0, 1}, {int(right), 2}, {int(jump), 1}, {int(right), 1},
+		}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			d := New("level1", tt.ticks)
+			if !slices.Equal(d.Inputs, tt.inputs) {
+				t.Errorf("Inputs = %v, want %v", d.Inputs, tt.inputs)
+			}
+
+			data, err := d.Marshal()
+			if err != nil {
+				t.Fatal(err)
+			}
+			var decoded Demo
+			if err := json.Unmarshal(data, &decoded); err != nil {
+				t.Fatal(err)
+			}
+			if decoded.Level != "level1" {
+				t.Errorf("Level = %q, want level1", decoded.Level)
+			}
+			if got := decoded.Ticks(); !slices.Equal(got, tt.ticks) {
+				t.Errorf("Ticks() = %v, want %v", got, tt.ticks)
+			}
+		})
+	}
+}
+
+func TestBundled(t *testing.T) {
+	for _, d := range Bundled() {
+		if d.Level == "" || len(d.Ticks()) == 0 {
+			t.Errorf("bundled demo %+v is empty", d)
+		}
+	}
+}
@@ -1,20 +1,20 @@
 package components
 
 import (
-	"time"
-
 	"github.com/hajimehoshi/ebiten/v2"
 )
 
+// Animation cycles through Images, advancing every FrameTicks simulation ticks.
 type Animation struct {
-	Duration            time.Duration
-	Images              []*ebiten.Image
-	lastChangeTimestamp time.Time
-	lastImage           int
+	FrameTicks int
+	Images     []*ebiten.Image
+	ticks      int
+	lastImage  int
 }
 
 func (a *Animation) CheckAndGetImage() *ebiten.Image {
-	if time.Now().Compare(a.lastChangeTimestamp.Add(a.Duration)) < 1 {
+	a.ticks++
+	if a.ticks <= a.FrameTicks {
 		return nil
 	}
 
@@ -27,7 +27,7 @@ func (a *Animation) CheckAndGetImage() *ebiten.Image {
 		a.lastImage = 0
 	}
 
-	a.lastChangeTimestamp = time.Now()
+	a.ticks = 0
 
 	return img
 }
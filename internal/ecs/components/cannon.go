@@ -0,0 +1,129 @@
+package components
+
+type CannonMode int
+
+const (
+	// CannonFixed fires along Direction.
+	CannonFixed CannonMode = iota
+	// CannonTracking turns towards the player, at most TurnRate radians per tick.
+	CannonTracking
+	// CannonSweep swings Direction between SweepFrom and SweepTo.
+	CannonSweep
+)
+
+// CannonShot is one step of a scripted fire pattern. Delay is counted from the
+// previous step; Angle is added to the current aim.
+type CannonShot struct {
+	Delay  int
+	Angle  float64
+	Count  int
+	Spread float64
+	Speed  float64
+}
+
+type Cannon struct {
+	FireRate            int
+	FramesSinceLastShot int
+	ProjectileSpeed     float64
+	ProjectileMass      float64
+	ProjectileType      string
+	Direction           float64
+	Active              bool
+
+	BurstCount           int
+	BurstDelay           int
+	CurrentBurst         int
+	FramesSinceLastBurst int
+
+	Mode         CannonMode
+	TurnRate     float64
+	SweepFrom    float64
+	SweepTo      float64
+	SweepSpeed   float64
+	SweepReverse bool
+
+	// every volley fires SpreadCount projectiles fanned over SpreadAngle
+	SpreadCount int
+	SpreadAngle float64
+
+	// a non-empty Timeline replaces FireRate and bursts
+	Timeline      []CannonShot
+	TimelineStep  int
+	TimelineTicks int
+
+	// TelegraphTicks of wind-up precede every volley; the aim is locked
+	// while Charging
+	TelegraphTicks int
+	Charging       bool
+}
+
+func DefaultCannon() Cannon {
+	return Cannon{
+		FireRate:             120,
+		FramesSinceLastShot:  0,
+		ProjectileSpeed:      4.0,
+		ProjectileMass:       2.0,
+		Direction:            3.14159,
+		Active:               true,
+		BurstCount:           1,
+		BurstDelay:           5,
+		CurrentBurst:         0,
+		FramesSinceLastBurst: 0,
+		SpreadCount:          1,
+		TelegraphTicks:       30,
+	}
+}
+
+// TicksUntilShot is the time left before the next volley.
+func (c *Cannon) TicksUntilShot() int {
+	if len(c.Timeline) > 0 {
+		return c.Interval() - c.TimelineTicks
+	}
+	return c.FireRate - c.FramesSinceLastShot
+}
+
+// Interval is the full wait before the next volley. A timeline without any
+// delay would fire every tick, so its first step waits FireRate instead.
+func (c *Cannon) Interval() int {
+	if len(c.Timeline) == 0 {
+		return c.FireRate
+	}
+	step := c.TimelineStep % len(c.Timeline)
+	if step == 0 && !c.timelineWaits() {
+		return c.FireRate
+	}
+	return c.Timeline[step].Delay
+}
+
+// Telegraph is the wind-up before the next volley: TelegraphTicks, but never
+// more than half the interval, so the cannon still aims between shots.
+func (c *Cannon) Telegraph() int {
+	return min(c.TelegraphTicks, c.Interval()/2)
+}
+
+func (c *Cannon) timelineWaits() bool {
+	for _, shot := range c.Timeline {
+		if shot.Delay > 0 {
+			return true
+		}
+	}
+	return false
+}
+
+type TriggerAction int
+
+const (
+	TriggerActivate TriggerAction = iota
+	TriggerDeactivate
+	TriggerToggle
+)
+
+// CannonTrigger switches the Active flag of Targets (cannon entity IDs) when
+// the player enters the trigger's collision area.
+type CannonTrigger struct {
+	Targets []int64
+	Action  TriggerAction
+	Once    bool
+	Inside  bool
+	Fired   bool
+}
@@ -0,0 +1,38 @@
+package components
+
+import "testing"
+
+func TestCannonIntervalAndTelegraph(t *testing.T) {
+	tests := []struct {
+		name      string
+		fireRate  int
+		timeline  []CannonShot
+		step      int
+		interval  int
+		telegraph int
+	}{
+		{"slow cannon", 120, nil, 0, 120, 30},
+		{"fast cannon", 30, nil, 0, 30, 15},
+		{"every tick", 1, nil, 0, 1, 0},
+		{"timeline step", 120, []CannonShot{{Delay: 40}, {Delay: 0}}, 0, 40, 20},
+		{"zero delay step", 120, []CannonShot{{Delay: 40}, {Delay: 0}}, 1, 0, 0},
+		{"delay-free timeline waits FireRate", 90, []CannonShot{{}, {}}, 0, 90, 30},
+		{"delay-free timeline rest of the volley", 90, []CannonShot{{}, {}}, 1, 0, 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := DefaultCannon()
+			c.FireRate = tt.fireRate
+			c.Timeline = tt.timeline
+			c.TimelineStep = tt.step
+
+			if got := c.Interval(); got != tt.interval {
+				t.Errorf("Interval() = %d, want %d", got, tt.interval)
+			}
+			if got := c.Telegraph(); got != tt.telegraph {
+				t.Errorf("Telegraph() = %d, want %d", got, tt.telegraph)
+			}
+		})
+	}
+}
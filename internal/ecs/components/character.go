@@ -1,8 +1,3 @@
 package components
 
-import "github.com/hajimehoshi/ebiten/v2"
-
-type Character struct {
-	GroundedSprite *ebiten.Image
-	JumpingSprite  *ebiten.Image
-}
+type Character struct{}
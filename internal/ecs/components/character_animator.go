@@ -0,0 +1,89 @@
+package components
+
+import "github.com/hajimehoshi/ebiten/v2"
+
+type AnimationState int
+
+const (
+	AnimIdle AnimationState = iota
+	AnimRun
+	AnimJumpRise
+	AnimFall
+	AnimLand
+	AnimDeath
+	AnimWallSlide
+)
+
+func (s AnimationState) IsAirborne() bool {
+	return s == AnimJumpRise || s == AnimFall || s == AnimWallSlide
+}
+
+type AnimationClip struct {
+	Frames     []*ebiten.Image
+	FrameTicks []int
+	Loop       bool
+}
+
+func (c AnimationClip) Duration() int {
+	total := 0
+	for _, t := range c.FrameTicks {
+		total += t
+	}
+	return total
+}
+
+func (c AnimationClip) frameAt(tick int) int {
+	total := c.Duration()
+	if total <= 0 {
+		return 0
+	}
+
+	if c.Loop {
+		tick %= total
+	} else if tick >= total {
+		return len(c.Frames) - 1
+	}
+
+	for i, t := range c.FrameTicks {
+		if tick < t {
+			return i
+		}
+		tick -= t
+	}
+	return len(c.Frames) - 1
+}
+
+// CharacterAnimator is an animation state machine. StateTicks counts simulation
+// ticks spent in the current state and drives frame selection.
+type CharacterAnimator struct {
+	Clips      map[AnimationState]AnimationClip
+	State      AnimationState
+	StateTicks int
+	FacingLeft bool
+}
+
+func (a *CharacterAnimator) SetState(state AnimationState) {
+	if a.State == state {
+		a.StateTicks++
+		return
+	}
+	a.State = state
+	a.StateTicks = 0
+}
+
+func (a *CharacterAnimator) IsFinished() bool {
+	clip, ok := a.Clips[a.State]
+	return !ok || (!clip.Loop && a.StateTicks >= clip.Duration())
+}
+
+func (a *CharacterAnimator) CurrentFrame() *ebiten.Image {
+	clip, ok := a.Clips[a.State]
+	if !ok || len(clip.Frames) == 0 {
+		clip, ok = a.Clips[AnimIdle]
+		if !ok || len(clip.Frames) == 0 {
+			return nil
+		}
+	}
+
+	return clip.Frames[clip.frameAt(a.StateTicks)]
+}
@@ -0,0 +1,44 @@
+package components
+
+import "slices"
+
+type CollectibleKind int
+
+const (
+	CollectibleFruit CollectibleKind = iota
+	CollectibleShard
+)
+
+// Collectible is an optional pickup. ID is stable between sessions (it is
+// derived from the spawn position) so progress can be saved per level.
+type Collectible struct {
+	Kind CollectibleKind
+	ID   string
+	// Owned pickups were found in an earlier session and are drawn ghosted.
+	Owned bool
+}
+
+// HiddenWall is a solid-looking sprite hiding a secret area; it fades away
+// once the character steps inside it.
+type HiddenWall struct {
+	Revealed bool
+}
+
+// Collection is the level resource tracking collectibles found so far.
+type Collection struct {
+	Level    string
+	Found    []string
+	Total    int
+	Rewarded bool
+}
+
+// CloneComponent keeps a rewound world from appending into the finds of the
+// world it was cloned from.
+func (c Collection) CloneComponent() interface{} {
+	c.Found = slices.Clone(c.Found)
+	return c
+}
+
+func (c Collection) Complete() bool {
+	return c.Total > 0 && len(c.Found) >= c.Total
+}
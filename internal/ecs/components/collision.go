@@ -7,3 +7,10 @@ import (
 type Collision struct {
 	Shape resolv.IShape
 }
+
+func (c Collision) CloneComponent() interface{} {
+	if c.Shape == nil {
+		return c
+	}
+	return Collision{Shape: c.Shape.Clone()}
+}
@@ -1,6 +1,15 @@
 package components
 
+const (
+	CorpseFlashTicks = 12
+	// CorpseSettleTicks is how long a launched corpse has to rest before it
+	// turns back into a static platform
+	CorpseSettleTicks = 20
+)
+
 type Corpse struct {
 	Durability int64
 	IsSettled  bool
+	FlashTicks int
+	RestTicks  int
 }
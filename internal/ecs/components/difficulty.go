@@ -0,0 +1,16 @@
+package components
+
+// DifficultyRules is the level resource with the parts of the difficulty
+// profile that systems apply during play.
+type DifficultyRules struct {
+	// CorpseDurability is how many hits a corpse takes, -1 for never breaking
+	CorpseDurability int64
+	RespawnGrace     int
+}
+
+// Assist is the level resource with the player's assist options in effect.
+type Assist struct {
+	Invincible    bool
+	InfiniteLives bool
+	AirControl    bool
+}
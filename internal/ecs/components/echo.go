@@ -0,0 +1,46 @@
+package components
+
+import "slices"
+
+// Echo is a previous life of the level replaying its recorded inputs, one
+// per tick, through the same systems that move the character.
+type Echo struct {
+	Inputs []Button
+	Tick   int
+}
+
+// Input is what the echo's life held on its current tick.
+func (e Echo) Input() Input {
+	var in Input
+	if e.Tick < len(e.Inputs) {
+		in.Held = e.Inputs[e.Tick]
+	}
+	if e.Tick > 0 && e.Tick <= len(e.Inputs) {
+		in.Pressed = in.Held &^ e.Inputs[e.Tick-1]
+	} else {
+		in.Pressed = in.Held
+	}
+	return in
+}
+
+// Done reports whether the echo has lived its whole life again.
+func (e Echo) Done() bool {
+	return e.Tick >= len(e.Inputs)
+}
+
+// Echoes is the level resource of the echoes mode: the inputs of the life
+// being played and of every life lost before it in this level.
+type Echoes struct {
+	Current []Button
+	Lives   [][]Button
+}
+
+// CloneComponent keeps a rewound world from appending into the recording of
+// the world it was cloned from. Lost lives are never appended to again, so
+// they stay shared.
+func (e Echoes) CloneComponent() interface{} {
+	return Echoes{
+		Current: slices.Clone(e.Current),
+		Lives:   slices.Clone(e.Lives),
+	}
+}
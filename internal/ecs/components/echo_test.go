@@ -0,0 +1,31 @@
+package components
+
+import (
+	"testing"
+
+	"github.com/game-jam-2026/dead-jump/internal/ecs"
+)
+
+func TestEchoesClone(t *testing.T) {
+	w := ecs.NewWorld()
+	w.SetResource(Echoes{
+		Current: make([]Button, 1, 8),
+		Lives:   make([][]Button, 1, 8),
+	})
+	clone := w.Clone()
+
+	for i, world := range []*ecs.World{w, clone} {
+		echoes, _ := ecs.GetResource[Echoes](world)
+		echoes.Current = append(echoes.Current, Button(i+1))
+		echoes.Lives = append(echoes.Lives, []Button{Button(i + 1)})
+		world.SetResource(*echoes)
+	}
+
+	for i, world := range []*ecs.World{w, clone} {
+		echoes, _ := ecs.GetResource[Echoes](world)
+		want := Button(i + 1)
+		if echoes.Current[1] != want || echoes.Lives[1][0] != want {
+			t.Errorf("world %d recorded %v and %v, want %v", i, echoes.Current[1], echoes.Lives[1][0], want)
+		}
+	}
+}
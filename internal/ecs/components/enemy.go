@@ -0,0 +1,64 @@
+package components
+
+import "github.com/game-jam-2026/dead-jump/pkg/linalg"
+
+// Enemy kills the character on contact; stompable enemies die instead when
+// the character lands on them from above.
+type Enemy struct {
+	Stompable   bool
+	StompBounce float64
+}
+
+// Names of the machines in the systems enemy registry.
+const (
+	MachinePatrol = "patrol"
+	MachineWalker = "walker"
+	MachineFlyer  = "flyer"
+	// MachineGuard patrols and chases the character on sight.
+	MachineGuard = "guard"
+	// MachineBat flies around and swoops at the character on sight.
+	MachineBat = "bat"
+)
+
+// Brain runs a state machine from the systems enemy registry. The behaviors
+// of its states read their settings from Patrol, Walker, SineMover and
+// Chaser, so a machine works on any entity that has the matching components.
+type Brain struct {
+	Machine    string
+	State      string
+	StateTicks int
+	// Facing is -1 or 1
+	Facing float64
+	// LostTicks counts ticks since the target was last in sight
+	LostTicks int
+}
+
+// Patrol walks (or flies, with zero gravity) between Points in order.
+type Patrol struct {
+	Points []linalg.Vector2
+	Next   int
+	Speed  float64
+}
+
+// Walker walks forward and turns around at walls and ledges.
+type Walker struct {
+	Speed float64
+}
+
+// SineMover flies back and forth within Range of Origin, bobbing up and down.
+type SineMover struct {
+	Origin    linalg.Vector2
+	Range     float64
+	Speed     float64
+	Amplitude float64
+	Period    int
+	Ticks     int
+}
+
+// Chaser goes after the character once it is within SightRange and not
+// hidden behind solid shapes; it gives up after GiveUpTicks out of sight.
+type Chaser struct {
+	Speed       float64
+	SightRange  float64
+	GiveUpTicks int
+}
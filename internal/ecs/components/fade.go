@@ -0,0 +1,9 @@
+package components
+
+// Fade fades the sprite out over Duration ticks and then destroys the entity.
+type Fade struct {
+	Ticks    int
+	Duration int
+	// From is the starting alpha; zero means fully opaque
+	From float32
+}
@@ -0,0 +1,31 @@
+package components
+
+import "slices"
+
+// GhostFrame is the character's look on one tick of a recorded run.
+type GhostFrame struct {
+	X          float64        `json:"x"`
+	Y          float64        `json:"y"`
+	State      AnimationState `json:"s"`
+	StateTicks int            `json:"t"`
+	FacingLeft bool           `json:"l,omitempty"`
+}
+
+// Ghost replays recorded frames, one per tick. It has no collision, so
+// nothing in the level reacts to it.
+type Ghost struct {
+	Frames []GhostFrame
+	Tick   int
+}
+
+// GhostRecording is the level resource collecting frames of the current
+// attempt.
+type GhostRecording struct {
+	Frames []GhostFrame
+}
+
+// CloneComponent keeps a rewound world from appending into the frames of
+// the world it was cloned from.
+func (g GhostRecording) CloneComponent() interface{} {
+	return GhostRecording{Frames: slices.Clone(g.Frames)}
+}
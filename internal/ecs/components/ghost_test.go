@@ -0,0 +1,29 @@
+package components
+
+import (
+	"testing"
+
+	"github.com/game-jam-2026/dead-jump/internal/ecs"
+)
+
+func TestGhostRecordingClone(t *testing.T) {
+	w := ecs.NewWorld()
+	frames := make([]GhostFrame, 1, 8)
+	w.SetResource(GhostRecording{Frames: frames})
+	clone := w.Clone()
+
+	// both worlds record on; with a shared array they would overwrite
+	// each other's frames
+	for i, world := range []*ecs.World{w, clone} {
+		rec, _ := ecs.GetResource[GhostRecording](world)
+		rec.Frames = append(rec.Frames, GhostFrame{X: float64(i + 1)})
+		world.SetResource(*rec)
+	}
+
+	for i, world := range []*ecs.World{w, clone} {
+		rec, _ := ecs.GetResource[GhostRecording](world)
+		if got := rec.Frames[1].X; got != float64(i+1) {
+			t.Errorf("world %d frame X = %v, want %v", i, got, i+1)
+		}
+	}
+}
@@ -0,0 +1,86 @@
+package components
+
+import "github.com/game-jam-2026/dead-jump/pkg/linalg"
+
+// DeathCause is what killed the character; it picks the corpse left behind
+// and the sound.
+type DeathCause int
+
+const (
+	// DeathTouch leaves the plain corpse (enemies, saws)
+	DeathTouch DeathCause = iota
+	// DeathSpike impales the corpse on the spike, covering it
+	DeathSpike
+	// DeathProjectile throws a ragdoll corpse along the impact
+	DeathProjectile
+	// DeathCrush leaves a flattened corpse (crushers, falling blocks)
+	DeathCrush
+	// DeathFall keeps the corpse falling
+	DeathFall
+	// DeathLava dissolves the body (lava, acid)
+	DeathLava
+	// DeathSacrifice leaves the corpse where the player chose to die
+	DeathSacrifice
+)
+
+type HazardSide int
+
+const (
+	HazardSideAny HazardSide = iota
+	// HazardSideBelow only kills a character underneath the hazard
+	HazardSideBelow
+)
+
+// Hazard kills the character on contact; Disarmed hazards are harmless for
+// the moment, e.g. a crusher going back up.
+type Hazard struct {
+	Cause           DeathCause
+	Side            HazardSide
+	Disarmed        bool
+	DissolveCorpses bool
+}
+
+// Saw spins its sprite; with a Patrol it also follows the patrol path.
+type Saw struct {
+	Spin float64
+}
+
+type FallingState int
+
+const (
+	FallingIdle FallingState = iota
+	FallingShaking
+	FallingDropping
+	FallingSettled
+)
+
+// FallingBlock drops when the character walks underneath and settles as a
+// platform where it lands.
+type FallingBlock struct {
+	State      FallingState
+	Ticks      int
+	ShakeTicks int
+	Origin     linalg.Vector2
+	// Reach is how far below the block the character is noticed
+	Reach float64
+}
+
+type CrusherState int
+
+const (
+	CrusherWaitTop CrusherState = iota
+	CrusherFalling
+	CrusherWaitBottom
+	CrusherRising
+)
+
+// Crusher slams down from Top to Bottom (position Y) and slowly rises back.
+type Crusher struct {
+	State     CrusherState
+	Ticks     int
+	Top       float64
+	Bottom    float64
+	DownSpeed float64
+	UpSpeed   float64
+	WaitTicks int
+}
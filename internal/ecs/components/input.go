@@ -0,0 +1,25 @@
+package components
+
+type Button uint8
+
+const (
+	ButtonLeft Button = 1 << iota
+	ButtonRight
+	ButtonJump
+	ButtonSacrifice
+)
+
+// Input is the resource with the buttons the character obeys on this tick,
+// whether they come from the keyboard or a recording.
+type Input struct {
+	Held    Button
+	Pressed Button
+}
+
+func (i Input) IsHeld(b Button) bool {
+	return i.Held&b != 0
+}
+
+func (i Input) JustPressed(b Button) bool {
+	return i.Pressed&b != 0
+}
@@ -0,0 +1,7 @@
+package components
+
+// Invulnerable characters are ignored by hazards. Ticks counts a respawn
+// grace period down; zero is permanent (console god mode).
+type Invulnerable struct {
+	Ticks int
+}
@@ -0,0 +1,24 @@
+package components
+
+// fallMargin is how far below the level's bottom edge the kill plane lies,
+// so a body visibly drops out of the screen first.
+const fallMargin = 48
+
+// LevelBounds is the level resource declaring where play happens: the
+// character and echoes are kept between Left and Right, die once they fall
+// below KillY, and the camera never shows anything under Bottom.
+type LevelBounds struct {
+	Left, Right float64
+	Bottom      float64
+	KillY       float64
+	// NoFallCorpse makes fall deaths leave nothing behind
+	NoFallCorpse bool
+}
+
+func NewLevelBounds(width, height float64) LevelBounds {
+	return LevelBounds{
+		Right:  width,
+		Bottom: height,
+		KillY:  height + fallMargin,
+	}
+}
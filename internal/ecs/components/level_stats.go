@@ -0,0 +1,8 @@
+package components
+
+// LevelStats is the level resource counting time and deaths since the level
+// was loaded.
+type LevelStats struct {
+	Ticks  int
+	Deaths int
+}
@@ -0,0 +1,20 @@
+package components
+
+type ParallaxAnchor int
+
+const (
+	ParallaxAnchorNone ParallaxAnchor = iota
+	ParallaxAnchorTop
+	ParallaxAnchorBottom
+)
+
+// ParallaxLayer marks a sprite as a background layer. Scroll factors are
+// relative to the camera: 0 keeps the layer fixed on screen, 1 moves it
+// together with gameplay. Position acts as an offset from the anchor.
+type ParallaxLayer struct {
+	ScrollX float64
+	ScrollY float64
+	RepeatX bool
+	RepeatY bool
+	Anchor  ParallaxAnchor
+}
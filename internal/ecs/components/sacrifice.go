@@ -0,0 +1,24 @@
+package components
+
+// Sacrifices is the level resource limiting the sacrifice action. Levels
+// without it allow any number of sacrifices; a zero Limit is unlimited too.
+type Sacrifices struct {
+	Disabled bool
+	Limit    int
+	Used     int
+}
+
+func (s Sacrifices) Allowed() bool {
+	return !s.Disabled && (s.Limit == 0 || s.Used < s.Limit)
+}
+
+// Left is how many sacrifices remain; ok is false when they are unlimited.
+func (s Sacrifices) Left() (left int, ok bool) {
+	if s.Disabled {
+		return 0, true
+	}
+	if s.Limit == 0 {
+		return 0, false
+	}
+	return max(0, s.Limit-s.Used), true
+}
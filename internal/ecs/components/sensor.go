@@ -0,0 +1,5 @@
+package components
+
+// Sensor colliders show up in collision results but are never pushed apart
+// from what they touch (triggers, lava).
+type Sensor struct{}
@@ -0,0 +1,68 @@
+package components
+
+import "github.com/game-jam-2026/dead-jump/pkg/linalg"
+
+// Signals are named channels. Emitters (plates, levers, buttons, logic
+// gates) drive a channel, a SignalReceiver follows one. Several emitters on
+// one channel are OR-ed.
+
+// PressurePlate is held down by the character, corpses and settled
+// projectiles.
+type PressurePlate struct {
+	Output  string
+	Pressed bool
+}
+
+// Lever flips every time the character walks into it.
+type Lever struct {
+	Output   string
+	On       bool
+	Touching bool
+}
+
+// TimedButton stays on for Duration ticks after the character touches it.
+type TimedButton struct {
+	Output   string
+	Duration int
+	Ticks    int
+}
+
+type LogicOp int
+
+const (
+	LogicAnd LogicOp = iota
+	LogicOr
+	// LogicNot inverts the first input
+	LogicNot
+	// LogicTimer follows the first input and holds it on for Duration ticks
+	// after it drops
+	LogicTimer
+)
+
+type LogicGate struct {
+	Op       LogicOp
+	Inputs   []string
+	Output   string
+	Duration int
+	Ticks    int
+}
+
+// SignalReceiver makes the entity follow a channel: cannons fire, spikes
+// retract, doors open and moving platforms move while Active.
+type SignalReceiver struct {
+	Input  string
+	Invert bool
+	Active bool
+}
+
+// Door slides from Closed by OpenOffset while its receiver is active.
+type Door struct {
+	Closed     linalg.Vector2
+	OpenOffset linalg.Vector2
+	Speed      float64
+	Progress   float64
+}
+
+// MovingPlatform follows its Patrol path, carrying whatever stands on it.
+// With a SignalReceiver it only moves while the signal is on.
+type MovingPlatform struct{}
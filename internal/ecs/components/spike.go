@@ -1,3 +1,6 @@
 package components
 
-type Spike struct{}
+// Retracted spikes are harmless; signals retract them.
+type Spike struct {
+	Retracted bool
+}
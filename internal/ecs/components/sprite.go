@@ -1,8 +1,80 @@
 package components
 
-import "github.com/hajimehoshi/ebiten/v2"
+import (
+	"github.com/hajimehoshi/ebiten/v2"
 
+	"github.com/game-jam-2026/dead-jump/pkg/linalg"
+)
+
+// Sprite transforms are applied at draw time. Position is the top-left corner
+// of the scaled sprite; Origin is the pivot for rotation, in image pixels.
+// Zero Scale is treated as 1, zero ColorScale and Blend are the identity.
 type Sprite struct {
-	Image  *ebiten.Image
-	ZIndex int
+	Image      *ebiten.Image
+	ZIndex     int
+	Origin     linalg.Vector2
+	FlipX      bool
+	FlipY      bool
+	Rotation   float64
+	Scale      linalg.Vector2
+	ColorScale ebiten.ColorScale
+	Blend      ebiten.Blend
+}
+
+func (s *Sprite) EffectiveScale() linalg.Vector2 {
+	if s.Scale.IsZero() {
+		return linalg.Vector2{X: 1, Y: 1}
+	}
+	return s.Scale
+}
+
+func (s *Sprite) SetUniformScale(scale float64) {
+	s.Scale = linalg.Vector2{X: scale, Y: scale}
+}
+
+func (s *Sprite) Size() (float64, float64) {
+	if s.Image == nil {
+		return 0, 0
+	}
+	bounds := s.Image.Bounds()
+	scale := s.EffectiveScale()
+	return float64(bounds.Dx()) * scale.X, float64(bounds.Dy()) * scale.Y
+}
+
+func (s *Sprite) CenterOrigin() {
+	if s.Image == nil {
+		return
+	}
+	bounds := s.Image.Bounds()
+	s.Origin = linalg.Vector2{X: float64(bounds.Dx()) / 2, Y: float64(bounds.Dy()) / 2}
+}
+
+// DrawOptions builds draw options placing the sprite at screenPos.
+func (s *Sprite) DrawOptions(screenPos linalg.Vector2) *ebiten.DrawImageOptions {
+	op := &ebiten.DrawImageOptions{}
+	bounds := s.Image.Bounds()
+	w, h := float64(bounds.Dx()), float64(bounds.Dy())
+
+	if s.FlipX {
+		op.GeoM.Scale(-1, 1)
+		op.GeoM.Translate(w, 0)
+	}
+	if s.FlipY {
+		op.GeoM.Scale(1, -1)
+		op.GeoM.Translate(0, h)
+	}
+
+	scale := s.EffectiveScale()
+	op.GeoM.Translate(-s.Origin.X, -s.Origin.Y)
+	op.GeoM.Scale(scale.X, scale.Y)
+	if s.Rotation != 0 {
+		op.GeoM.Rotate(s.Rotation)
+	}
+	op.GeoM.Translate(s.Origin.X*scale.X, s.Origin.Y*scale.Y)
+	op.GeoM.Translate(screenPos.X, screenPos.Y)
+
+	op.ColorScale = s.ColorScale
+	op.Blend = s.Blend
+
+	return op
 }
@@ -19,13 +19,17 @@ func ApplyAnimation(world *ecs.World) {
 		}
 
 		img := animation.CheckAndGetImage()
+		world.SetComponent(entity, *animation)
 		if img == nil {
 			continue
 		}
 
-		world.SetComponent(entity, *animation)
-		world.SetComponent(entity, components.Sprite{
-			Image: img,
-		})
+		sprite, err := ecs.GetComponent[components.Sprite](world, entity)
+		if err != nil {
+			sprite = &components.Sprite{}
+		}
+		sprite.Image = img
+
+		world.SetComponent(entity, *sprite)
 	}
 }
@@ -0,0 +1,61 @@
+package systems
+
+import (
+	"github.com/game-jam-2026/dead-jump/internal/ecs"
+	"github.com/game-jam-2026/dead-jump/internal/ecs/components"
+	"github.com/game-jam-2026/dead-jump/internal/utils/audio"
+)
+
+// ApplyEnemyContacts kills the character on enemy contact, or the enemy when
+// the character lands on a stompable one. Returns true if the character died.
+func ApplyEnemyContacts(world *ecs.World, collisions []CollisionResult) bool {
+	for _, col := range collisions {
+		charID, enemyID := col.EntityA, col.EntityB
+		// normal points from B to A, so up means A is on top
+		fromAbove := col.Normal.Y < -0.5
+		if !isMortal(world, charID) {
+			charID, enemyID = col.EntityB, col.EntityA
+			fromAbove = col.Normal.Y > 0.5
+		}
+
+		if !isMortal(world, charID) {
+			continue
+		}
+
+		enemy, err := ecs.GetComponent[components.Enemy](world, enemyID)
+		if err != nil {
+			continue
+		}
+
+		if fromAbove && enemy.Stompable {
+			stompEnemy(world, charID, enemyID, enemy)
+			continue
+		}
+
+		if isInvulnerable(world, charID) {
+			continue
+		}
+
+		if KillCharacter(world, charID) {
+			return true
+		}
+	}
+	return false
+}
+
+func stompEnemy(world *ecs.World, charID, enemyID ecs.EntityID, enemy *components.Enemy) {
+	audio.Play(audio.SoundProjectileHit)
+	world.DestroyEntity(enemyID)
+
+	vel, err := ecs.GetComponent[components.Velocity](world, charID)
+	if err != nil {
+		return
+	}
+	vel.Vector.Y = -enemy.StompBounce
+	world.SetComponent(charID, *vel)
+
+	if body, err := ecs.GetComponent[components.PhysicsBody](world, charID); err == nil {
+		body.IsGrounded = false
+		world.SetComponent(charID, *body)
+	}
+}
@@ -3,10 +3,8 @@ package systems
 import (
 	"reflect"
 
-	"github.com/game-jam-2026/dead-jump/internal/assets"
 	"github.com/game-jam-2026/dead-jump/internal/ecs"
 	"github.com/game-jam-2026/dead-jump/internal/ecs/components"
-	"github.com/game-jam-2026/dead-jump/internal/utils"
 )
 
 func ApplySpikes(world *ecs.World) bool {
@@ -14,6 +12,10 @@ func ApplySpikes(world *ecs.World) bool {
 		reflect.TypeOf((*components.Character)(nil)).Elem(),
 		reflect.TypeOf((*components.Collision)(nil)).Elem(),
 	)
+	characters = append(characters, world.GetEntities(
+		reflect.TypeOf((*components.Echo)(nil)).Elem(),
+		reflect.TypeOf((*components.Collision)(nil)).Elem(),
+	)...)
 
 	spikes := world.GetEntities(
 		reflect.TypeOf((*components.Spike)(nil)).Elem(),
@@ -21,12 +23,20 @@ func ApplySpikes(world *ecs.World) bool {
 	)
 
 	for _, charEntity := range characters {
+		if isInvulnerable(world, charEntity) {
+			continue
+		}
+
 		charCollision, err := ecs.GetComponent[components.Collision](world, charEntity)
 		if err != nil {
 			continue
 		}
 
 		for _, spikeEntity := range spikes {
+			if spike, err := ecs.GetComponent[components.Spike](world, spikeEntity); err == nil && spike.Retracted {
+				continue
+			}
+
 			spikeCollision, err := ecs.GetComponent[components.Collision](world, spikeEntity)
 			if err != nil {
 				continue
@@ -34,8 +44,10 @@ func ApplySpikes(world *ecs.World) bool {
 
 			intersection := charCollision.Shape.Intersection(spikeCollision.Shape)
 			if !intersection.IsEmpty() {
-				utils.KillEntity(world, charEntity, assets.DeadHeroImage, 1, assets.CreateCharacter)
-				return true // Death occurred
+				if KillCharacterWith(world, charEntity, Death{Cause: components.DeathSpike, Source: spikeEntity}) {
+					return true // Death occurred
+				}
+				break
 			}
 		}
 	}
@@ -54,6 +54,11 @@ func ApplyVelocityWithCollisions(world *ecs.World, cfg *physics.Config) []Collis
 		allResults = append(allResults, results...)
 	}
 
+	world.SetResource(physics.Stats{
+		Substeps:   substeps,
+		Collisions: len(allResults),
+	})
+
 	return allResults
 }
 
@@ -157,6 +162,10 @@ func resolveCollisionsSubstep(world *ecs.World, cfg *physics.Config) []Collision
 			}
 			results = append(results, result)
 
+			if isSensor(world, entityA) || isSensor(world, entityB) || passThrough(world, entityA, entityB) {
+				continue
+			}
+
 			resolveCollision(
 				world, cfg,
 				entityA, entityB,
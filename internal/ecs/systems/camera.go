@@ -3,6 +3,7 @@ package systems
 import (
 	"math"
 	"reflect"
+	"slices"
 	"sort"
 
 	"github.com/game-jam-2026/dead-jump/internal/ecs"
@@ -47,8 +48,8 @@ func updateCameraPosition(world *ecs.World, camera *components.Camera) {
 	targetCenterX := targetPos.Vector.X + targetWidth/2
 	targetCenterY := targetPos.Vector.Y + targetHeight/2
 
-	desiredX := targetCenterX - camera.ViewportWidth/2
-	desiredY := targetCenterY - camera.ViewportHeight/2
+	desiredX := followDeadZone(camera.Position.X, camera.ViewportWidth, targetCenterX, camera.DeadZoneX)
+	desiredY := followDeadZone(camera.Position.Y, camera.ViewportHeight, targetCenterY, camera.DeadZoneY)
 
 	if camera.MaxX > camera.MinX {
 		desiredX = clamp(desiredX, camera.MinX, camera.MaxX-camera.ViewportWidth)
@@ -56,6 +57,11 @@ func updateCameraPosition(world *ecs.World, camera *components.Camera) {
 	if camera.MaxY > camera.MinY {
 		desiredY = clamp(desiredY, camera.MinY, camera.MaxY-camera.ViewportHeight)
 	}
+	// не спускаемся за падающим персонажем в пустоту
+	if bounds, err := ecs.GetResource[components.LevelBounds](world); err == nil {
+		desiredX = clamp(desiredX, bounds.Left, max(bounds.Left, bounds.Right-camera.ViewportWidth))
+		desiredY = min(desiredY, bounds.Bottom-camera.ViewportHeight)
+	}
 
 	smoothFactorX := 0.08
 	smoothFactorY := 0.05
@@ -67,11 +73,31 @@ func updateCameraPosition(world *ecs.World, camera *components.Camera) {
 	camera.Position.Y = math.Round(camera.Position.Y)
 }
 
+// followDeadZone is where the camera should be along one axis: it stays put
+// while the target is within zone of the view center and otherwise moves
+// only as far as the target left the zone.
+func followDeadZone(position, viewport, target, zone float64) float64 {
+	offset := target - (position + viewport/2)
+	switch {
+	case offset > zone:
+		return position + offset - zone
+	case offset < -zone:
+		return position + offset + zone
+	}
+	return position
+}
+
 func DrawSpritesWithCamera(world *ecs.World, screen *ebiten.Image, camera *components.Camera) {
+	drawParallaxLayers(world, screen, camera)
+
 	entities := world.GetEntities(
 		reflect.TypeOf((*components.Position)(nil)).Elem(),
 		reflect.TypeOf((*components.Sprite)(nil)).Elem(),
 	)
+	entities = slices.DeleteFunc(entities, func(e ecs.EntityID) bool {
+		_, err := ecs.GetComponent[components.ParallaxLayer](world, e)
+		return err == nil
+	})
 
 	sort.Slice(entities, func(i, j int) bool {
 		spriteI, _ := ecs.GetComponent[components.Sprite](world, entities[i])
@@ -102,25 +128,98 @@ func DrawSpritesWithCamera(world *ecs.World, screen *ebiten.Image, camera *compo
 			continue
 		}
 
-		bounds := sprite.Image.Bounds()
-		spriteWidth := float64(bounds.Dx())
-		spriteHeight := float64(bounds.Dy())
+		if sprite.Image == nil {
+			continue
+		}
+		spriteWidth, spriteHeight := sprite.Size()
+
+		if !camera.IsVisible(pos.Vector, spriteWidth, spriteHeight) {
+			continue
+		}
+		screenPos := camera.WorldToScreen(pos.Vector)
+
+		screen.DrawImage(sprite.Image, sprite.DrawOptions(screenPos))
+	}
+}
+
+func drawParallaxLayers(world *ecs.World, screen *ebiten.Image, camera *components.Camera) {
+	layers := world.GetEntities(
+		reflect.TypeOf((*components.ParallaxLayer)(nil)).Elem(),
+		reflect.TypeOf((*components.Position)(nil)).Elem(),
+		reflect.TypeOf((*components.Sprite)(nil)).Elem(),
+	)
+
+	sort.Slice(layers, func(i, j int) bool {
+		spriteI, _ := ecs.GetComponent[components.Sprite](world, layers[i])
+		spriteJ, _ := ecs.GetComponent[components.Sprite](world, layers[j])
+
+		if spriteI.ZIndex != spriteJ.ZIndex {
+			return spriteI.ZIndex < spriteJ.ZIndex
+		}
+		return layers[i] < layers[j]
+	})
+
+	for _, e := range layers {
+		layer, err := ecs.GetComponent[components.ParallaxLayer](world, e)
+		if err != nil {
+			continue
+		}
+		pos, err := ecs.GetComponent[components.Position](world, e)
+		if err != nil {
+			continue
+		}
+		sprite, err := ecs.GetComponent[components.Sprite](world, e)
+		if err != nil || sprite.Image == nil {
+			continue
+		}
+
+		width, height := sprite.Size()
+
+		x := pos.Vector.X - camera.Position.X*layer.ScrollX
+		y := pos.Vector.Y - camera.Position.Y*layer.ScrollY
 
-		_, isScreenSpace := ecs.GetComponent[components.ScreenSpace](world, e)
+		switch layer.Anchor {
+		case components.ParallaxAnchorTop:
+			y = pos.Vector.Y - (camera.Position.Y-camera.MinY)*layer.ScrollY
+		case components.ParallaxAnchorBottom:
+			bottom := 0.0
+			if camera.MaxY > camera.MinY {
+				bottom = camera.MaxY - camera.ViewportHeight
+			}
+			y = camera.ViewportHeight - height + pos.Vector.Y - (camera.Position.Y-bottom)*layer.ScrollY
+		}
 
-		var screenPos linalg.Vector2
-		if isScreenSpace == nil {
-			screenPos = pos.Vector
-		} else {
-			if !camera.IsVisible(pos.Vector, spriteWidth, spriteHeight) {
-				continue
+		startX, endX := x, x
+		if layer.RepeatX && width > 0 {
+			startX = math.Mod(x, width)
+			if startX > 0 {
+				startX -= width
 			}
-			screenPos = camera.WorldToScreen(pos.Vector)
+			endX = camera.ViewportWidth
 		}
 
-		op := &ebiten.DrawImageOptions{}
-		op.GeoM.Translate(screenPos.X, screenPos.Y)
-		screen.DrawImage(sprite.Image, op)
+		startY, endY := y, y
+		if layer.RepeatY && height > 0 {
+			startY = math.Mod(y, height)
+			if startY > 0 {
+				startY -= height
+			}
+			endY = camera.ViewportHeight
+		}
+
+		for tileY := startY; tileY <= endY; tileY += height {
+			for tileX := startX; tileX <= endX; tileX += width {
+				tilePos := linalg.Vector2{X: math.Round(tileX), Y: math.Round(tileY)}
+				screen.DrawImage(sprite.Image, sprite.DrawOptions(tilePos))
+
+				if width <= 0 {
+					break
+				}
+			}
+			if height <= 0 {
+				break
+			}
+		}
 	}
 }
 
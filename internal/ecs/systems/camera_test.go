@@ -0,0 +1,26 @@
+package systems
+
+import "testing"
+
+func TestFollowDeadZone(t *testing.T) {
+	// a 320 wide view at 100 is centered on 260
+	tests := []struct {
+		name   string
+		target float64
+		want   float64
+	}{
+		{"at the center", 260, 100},
+		{"inside the zone", 275, 100},
+		{"on the edge", 280, 100},
+		{"past the right edge", 290, 110},
+		{"past the left edge", 230, 90},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := followDeadZone(100, 320, tt.target, 20); got != tt.want {
+				t.Errorf("followDeadZone() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
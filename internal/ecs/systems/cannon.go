@@ -14,15 +14,32 @@ import (
 	"github.com/game-jam-2026/dead-jump/pkg/linalg"
 )
 
+const (
+	cannonSize         = 32
+	cannonSpawnOffset  = 28
+	telegraphFlashRate = 4
+)
+
 func UpdateCannons(world *ecs.World) {
 	entities := world.GetEntities(
 		reflect.TypeOf((*components.Cannon)(nil)).Elem(),
 		reflect.TypeOf((*components.Position)(nil)).Elem(),
 	)
 
+	target, hasTarget := findCannonTarget(world)
+
 	for _, e := range entities {
 		cannon, err := ecs.GetComponent[components.Cannon](world, e)
-		if err != nil || !cannon.Active {
+		if err != nil {
+			continue
+		}
+
+		if !cannon.Active {
+			if cannon.Charging {
+				cannon.Charging = false
+				setCannonTint(world, e, 0, false)
+				world.SetComponent(e, *cannon)
+			}
 			continue
 		}
 
@@ -31,67 +48,251 @@ func UpdateCannons(world *ecs.World) {
 			continue
 		}
 
+		if !cannon.Charging {
+			aimCannon(cannon, pos, target, hasTarget)
+		}
+
 		if cannon.CurrentBurst > 0 {
 			cannon.FramesSinceLastBurst++
 
 			if cannon.FramesSinceLastBurst >= cannon.BurstDelay {
 				cannon.FramesSinceLastBurst = 0
-				fireProjectile(world, pos, cannon)
+				fireVolley(world, pos, cannon, components.CannonShot{})
 				cannon.CurrentBurst--
 			}
+			world.SetComponent(e, *cannon)
+			continue
+		}
+
+		if len(cannon.Timeline) > 0 {
+			cannon.TimelineTicks++
 		} else {
 			cannon.FramesSinceLastShot++
+		}
 
-			if cannon.FramesSinceLastShot >= cannon.FireRate {
-				cannon.FramesSinceLastShot = 0
-				cannon.CurrentBurst = cannon.BurstCount
-				cannon.FramesSinceLastBurst = 0
-
-				fireProjectile(world, pos, cannon)
-				cannon.CurrentBurst--
+		until := cannon.TicksUntilShot()
+		if until > 0 {
+			telegraph := cannon.Telegraph()
+			if !cannon.Charging && until <= telegraph {
+				cannon.Charging = true
+				audio.Play(audio.SoundCannonCharge)
 			}
+			if cannon.Charging {
+				progress := 1 - float64(until)/float64(max(1, telegraph))
+				setCannonTint(world, e, progress, (until/telegraphFlashRate)%2 == 0)
+			}
+			world.SetComponent(e, *cannon)
+			continue
+		}
+
+		if cannon.Charging {
+			cannon.Charging = false
+			setCannonTint(world, e, 0, false)
+		}
+
+		if len(cannon.Timeline) > 0 {
+			step := cannon.Timeline[cannon.TimelineStep%len(cannon.Timeline)]
+			cannon.TimelineStep = (cannon.TimelineStep + 1) % len(cannon.Timeline)
+			cannon.TimelineTicks = 0
+			fireVolley(world, pos, cannon, step)
+		} else {
+			cannon.FramesSinceLastShot = 0
+			cannon.CurrentBurst = cannon.BurstCount
+			cannon.FramesSinceLastBurst = 0
+
+			fireVolley(world, pos, cannon, components.CannonShot{})
+			cannon.CurrentBurst--
 		}
 
 		world.SetComponent(e, *cannon)
 	}
 }
 
-func fireProjectile(world *ecs.World, pos *components.Position, cannon *components.Cannon) {
-	spawnDistance := float64(28)
-	cannonCenterX := pos.Vector.X + 16
-	cannonCenterY := pos.Vector.Y + 16
+func findCannonTarget(world *ecs.World) (linalg.Vector2, bool) {
+	characters := world.GetEntities(
+		reflect.TypeOf((*components.Character)(nil)).Elem(),
+		reflect.TypeOf((*components.Position)(nil)).Elem(),
+	)
+	if len(characters) == 0 {
+		return linalg.Zero(), false
+	}
+
+	return entityCenter(world, characters[0])
+}
+
+func aimCannon(cannon *components.Cannon, pos *components.Position, target linalg.Vector2, hasTarget bool) {
+	switch cannon.Mode {
+	case components.CannonTracking:
+		if !hasTarget {
+			return
+		}
+		desired := target.Sub(cannonCenter(pos)).Angle()
+		delta := math.Remainder(desired-cannon.Direction, 2*math.Pi)
+		if cannon.TurnRate > 0 {
+			delta = clamp(delta, -cannon.TurnRate, cannon.TurnRate)
+		}
+		cannon.Direction = math.Remainder(cannon.Direction+delta, 2*math.Pi)
 
-	spawnX := cannonCenterX + math.Cos(cannon.Direction)*spawnDistance
-	spawnY := cannonCenterY + math.Sin(cannon.Direction)*spawnDistance
+	case components.CannonSweep:
+		from := min(cannon.SweepFrom, cannon.SweepTo)
+		to := max(cannon.SweepFrom, cannon.SweepTo)
 
-	velocity := linalg.Vector2{
-		X: math.Cos(cannon.Direction) * cannon.ProjectileSpeed,
-		Y: math.Sin(cannon.Direction) * cannon.ProjectileSpeed,
+		if cannon.SweepReverse {
+			cannon.Direction -= cannon.SweepSpeed
+		} else {
+			cannon.Direction += cannon.SweepSpeed
+		}
+
+		if cannon.Direction >= to {
+			cannon.Direction = to
+			cannon.SweepReverse = true
+		} else if cannon.Direction <= from {
+			cannon.Direction = from
+			cannon.SweepReverse = false
+		}
+	}
+}
+
+func cannonCenter(pos *components.Position) linalg.Vector2 {
+	return pos.Vector.Add(linalg.Vector2{X: cannonSize / 2, Y: cannonSize / 2})
+}
+
+// setCannonTint flashes the cannon red while it winds up; progress runs from
+// 0 (idle) to 1 (about to fire).
+func setCannonTint(world *ecs.World, e ecs.EntityID, progress float64, flash bool) {
+	sprite, err := ecs.GetComponent[components.Sprite](world, e)
+	if err != nil {
+		return
+	}
+
+	sprite.ColorScale.Reset()
+	if progress > 0 {
+		heat := float32(progress)
+		if flash {
+			heat = min(1, heat*1.5)
+		}
+		sprite.ColorScale.Scale(1+heat, 1-heat*0.6, 1-heat*0.6, 1)
+	}
+
+	world.SetComponent(e, *sprite)
+}
+
+// fireVolley fires one shot, or a fan of SpreadCount (or step.Count) shots,
+// around the cannon direction plus step.Angle.
+func fireVolley(world *ecs.World, pos *components.Position, cannon *components.Cannon, step components.CannonShot) {
+	count := cannon.SpreadCount
+	spread := cannon.SpreadAngle
+	if step.Count > 0 {
+		count, spread = step.Count, step.Spread
+	}
+	count = max(1, count)
+
+	speed := cannon.ProjectileSpeed
+	if step.Speed > 0 {
+		speed = step.Speed
+	}
+
+	center := cannon.Direction + step.Angle
+	for i := 0; i < count; i++ {
+		angle := center
+		if count > 1 {
+			angle += spread * (float64(i)/float64(count-1) - 0.5)
+		}
+		fireProjectile(world, pos, angle, speed, cannon.ProjectileMass, cannon.ProjectileType)
 	}
 
-	spawnProjectile(world, spawnX, spawnY, velocity, cannon.ProjectileMass)
 	audio.Play(audio.SoundCannonShot)
 }
 
-func spawnProjectile(world *ecs.World, x, y float64, velocity linalg.Vector2, mass float64) ecs.EntityID {
+func fireProjectile(world *ecs.World, pos *components.Position, angle, speed, mass float64, kind string) {
+	center := cannonCenter(pos)
+	dir := linalg.FromAngle(angle)
+
+	spawn := center.Add(dir.Scale(cannonSpawnOffset))
+	spawnProjectile(world, spawn.X, spawn.Y, dir.Scale(speed), mass, kind)
+}
+
+// ApplyCannonTriggers toggles cannons when the player enters a trigger area.
+func ApplyCannonTriggers(world *ecs.World) {
+	triggers := world.GetEntities(
+		reflect.TypeOf((*components.CannonTrigger)(nil)).Elem(),
+		reflect.TypeOf((*components.Collision)(nil)).Elem(),
+	)
+
+	characters := world.GetEntities(
+		reflect.TypeOf((*components.Character)(nil)).Elem(),
+		reflect.TypeOf((*components.Collision)(nil)).Elem(),
+	)
+
+	for _, e := range triggers {
+		trigger, err := ecs.GetComponent[components.CannonTrigger](world, e)
+		if err != nil || (trigger.Once && trigger.Fired) {
+			continue
+		}
+
+		col, err := ecs.GetComponent[components.Collision](world, e)
+		if err != nil {
+			continue
+		}
+
+		inside := false
+		for _, c := range characters {
+			charCol, err := ecs.GetComponent[components.Collision](world, c)
+			if err == nil && !col.Shape.Intersection(charCol.Shape).IsEmpty() {
+				inside = true
+				break
+			}
+		}
+
+		if inside && !trigger.Inside {
+			trigger.Fired = true
+			for _, target := range trigger.Targets {
+				applyCannonTrigger(world, ecs.EntityID(target), trigger.Action)
+			}
+		}
+
+		trigger.Inside = inside
+		world.SetComponent(e, *trigger)
+	}
+}
+
+func applyCannonTrigger(world *ecs.World, e ecs.EntityID, action components.TriggerAction) {
+	cannon, err := ecs.GetComponent[components.Cannon](world, e)
+	if err != nil {
+		return
+	}
+
+	switch action {
+	case components.TriggerActivate:
+		cannon.Active = true
+	case components.TriggerDeactivate:
+		cannon.Active = false
+	case components.TriggerToggle:
+		cannon.Active = !cannon.Active
+	}
+
+	world.SetComponent(e, *cannon)
+}
+
+func spawnProjectile(world *ecs.World, x, y float64, velocity linalg.Vector2, mass float64, kind string) ecs.EntityID {
+	t := GetProjectileType(kind)
+	if t.Mass > 0 {
+		mass = t.Mass
+	}
+
 	projectile := world.CreateEntity()
 
 	world.SetComponent(projectile, components.Position{
 		Vector: linalg.Vector2{X: x, Y: y},
 	})
 
-	size := 8
-	img := ebiten.NewImage(size, size)
-	for i := 0; i < size; i++ {
-		for j := 0; j < size; j++ {
-			dx := float64(i) - float64(size)/2
-			dy := float64(j) - float64(size)/2
-			if dx*dx+dy*dy < float64(size*size)/4 {
-				img.Set(i, j, color.RGBA{50, 50, 50, 255})
-			}
-		}
-	}
-	world.SetComponent(projectile, components.Sprite{Image: img})
+	img := t.Image()
+	size := img.Bounds().Dx()
+
+	sprite := components.Sprite{Image: img}
+	sprite.CenterOrigin()
+	sprite.Rotation = velocity.Angle()
+	world.SetComponent(projectile, sprite)
 
 	world.SetComponent(projectile, components.Collision{
 		Shape: resolv.NewRectangleFromTopLeft(x, y, float64(size), float64(size)),
@@ -100,19 +301,47 @@ func spawnProjectile(world *ecs.World, x, y float64, velocity linalg.Vector2, ma
 	world.SetComponent(projectile, components.Velocity{Vector: velocity})
 
 	body := components.ProjectileBody(mass)
-	body.Bounciness = 0.0
+	body.Bounciness = t.Bounciness
+	body.GravityScale = t.GravityScale
 	world.SetComponent(projectile, body)
 
 	world.SetComponent(projectile, components.Projectile{
 		ImpulseMagnitude:   mass * 3,
-		DestroyOnHit:       true,
-		Lifetime:           300,
+		DestroyOnHit:       t.OnHit != HitBounce,
+		Lifetime:           t.Lifetime,
 		MinSpeedForImpulse: 0.5,
+		Type:               t.Name,
 	})
 
 	return projectile
 }
 
+var cannonballImage *ebiten.Image
+
+func projectileImage() *ebiten.Image {
+	if cannonballImage != nil {
+		return cannonballImage
+	}
+
+	size := 8
+	img := ebiten.NewImage(size, size)
+	for i := 0; i < size; i++ {
+		for j := 0; j < size; j++ {
+			dx := float64(i) - float64(size)/2
+			dy := float64(j) - float64(size)/2
+			if dx*dx+dy*dy < float64(size*size)/4 {
+				img.Set(i, j, color.RGBA{50, 50, 50, 255})
+			}
+		}
+	}
+	// блик спереди, чтобы было видно вращение
+	img.Set(size-3, size/2-1, color.RGBA{110, 110, 110, 255})
+	img.Set(size-2, size/2-1, color.RGBA{90, 90, 90, 255})
+
+	cannonballImage = img
+	return cannonballImage
+}
+
 func HandleProjectileCollisions(world *ecs.World, collisions []CollisionResult) {
 	for _, col := range collisions {
 		projA, errA := ecs.GetComponent[components.Projectile](world, col.EntityA)
@@ -138,21 +367,50 @@ func HandleProjectileCollisions(world *ecs.World, collisions []CollisionResult)
 			continue
 		}
 
-		// Skip collision with screen-space entities (like life counter UI)
-		_, err := ecs.GetComponent[components.ScreenSpace](world, targetID)
-		if err == nil {
-			continue
-		}
+		t := GetProjectileType(proj.Type)
 
 		body, err := ecs.GetComponent[components.PhysicsBody](world, targetID)
 		if err == nil && body.IsStatic() {
-			world.DestroyEntity(projectileID)
+			if corpse, err := ecs.GetComponent[components.Corpse](world, targetID); err == nil {
+				corpse.FlashTicks = components.CorpseFlashTicks
+				if corpse.Durability > 0 {
+					corpse.Durability--
+				}
+				world.SetComponent(targetID, *corpse)
+				if corpse.Durability == 0 {
+					dissolveCorpse(world, targetID)
+				}
+			}
+
+			switch t.OnHit {
+			case HitBounce:
+				proj.Bounces++
+				if proj.Bounces >= t.MaxBounces {
+					world.DestroyEntity(projectileID)
+				} else {
+					world.SetComponent(projectileID, *proj)
+				}
+			case HitExplode:
+				explodeProjectile(world, projectileID, t)
+			case HitStick:
+				stickProjectile(world, projectileID, proj, t)
+			default:
+				world.DestroyEntity(projectileID)
+			}
 			continue
 		}
 
-		_, isCharacter := ecs.GetComponent[components.Character](world, targetID)
-		if isCharacter == nil {
+		if isMortal(world, targetID) {
 			audio.Play(audio.SoundProjectileHit)
+			if t.Lethal && !isInvulnerable(world, targetID) {
+				shootDown(world, projectileID, targetID)
+				continue
+			}
+			if t.OnHit == HitExplode {
+				explodeProjectile(world, projectileID, t)
+				continue
+			}
+
 			projVel, err := ecs.GetComponent[components.Velocity](world, projectileID)
 			if err == nil && projVel.Vector.Length() >= proj.MinSpeedForImpulse {
 				ApplyProjectileImpulse(world, projectileID, targetID, proj.ImpulseMagnitude)
@@ -167,6 +425,18 @@ func HandleProjectileCollisions(world *ecs.World, collisions []CollisionResult)
 	}
 }
 
+// shootDown kills the target of a lethal projectile; the corpse flies on
+// along the shot.
+func shootDown(world *ecs.World, projectileID, targetID ecs.EntityID) {
+	death := Death{Cause: components.DeathProjectile, Source: projectileID}
+	if vel, err := ecs.GetComponent[components.Velocity](world, projectileID); err == nil {
+		death.Impact = vel.Vector.Scale(ragdollImpactScale)
+	}
+
+	world.DestroyEntity(projectileID)
+	KillCharacterWith(world, targetID, death)
+}
+
 func UpdateProjectileLifetime(world *ecs.World) {
 	entities := world.GetEntities(
 		reflect.TypeOf((*components.Projectile)(nil)).Elem(),
@@ -213,6 +483,29 @@ func UpdateProjectileLifetime(world *ecs.World) {
 	}
 }
 
+func RotateProjectiles(world *ecs.World) {
+	entities := world.GetEntities(
+		reflect.TypeOf((*components.Projectile)(nil)).Elem(),
+		reflect.TypeOf((*components.Velocity)(nil)).Elem(),
+		reflect.TypeOf((*components.Sprite)(nil)).Elem(),
+	)
+
+	for _, e := range entities {
+		vel, err := ecs.GetComponent[components.Velocity](world, e)
+		if err != nil || vel.Vector.Length() < 0.5 {
+			continue
+		}
+
+		sprite, err := ecs.GetComponent[components.Sprite](world, e)
+		if err != nil {
+			continue
+		}
+
+		sprite.Rotation = vel.Vector.Angle()
+		world.SetComponent(e, *sprite)
+	}
+}
+
 func CleanupOffscreenProjectiles(world *ecs.World, screenWidth, screenHeight float64) {
 	entities := world.GetEntities(
 		reflect.TypeOf((*components.Projectile)(nil)).Elem(),
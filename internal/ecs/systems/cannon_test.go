@@ -0,0 +1,62 @@
+package systems
+
+import (
+	"testing"
+
+	"github.com/game-jam-2026/dead-jump/internal/ecs"
+	"github.com/game-jam-2026/dead-jump/internal/ecs/components"
+	"github.com/game-jam-2026/dead-jump/pkg/linalg"
+)
+
+func cannonWorld(cannon components.Cannon) (*ecs.World, ecs.EntityID) {
+	w := ecs.NewWorld()
+	e := w.CreateEntity()
+	w.SetComponent(e, components.Position{Vector: linalg.Vector2{X: 100, Y: 100}})
+	w.SetComponent(e, cannon)
+	return w, e
+}
+
+// A cannon firing faster than its telegraph still spends half of every
+// interval aiming.
+func TestFastCannonAimsBetweenShots(t *testing.T) {
+	cannon := components.DefaultCannon()
+	cannon.FireRate = 20
+	w, e := cannonWorld(cannon)
+
+	const ticks = 60
+	charging := 0
+	for range ticks {
+		UpdateCannons(w)
+		c, _ := ecs.GetComponent[components.Cannon](w, e)
+		if c.Charging {
+			charging++
+		}
+	}
+
+	if charging == 0 || charging > ticks/2 {
+		t.Errorf("charged %d of %d ticks, want some but at most half", charging, ticks)
+	}
+}
+
+func TestDelayFreeTimelineFiresAtFireRate(t *testing.T) {
+	cannon := components.DefaultCannon()
+	cannon.FireRate = 50
+	cannon.Timeline = []components.CannonShot{{}, {}}
+	w, e := cannonWorld(cannon)
+
+	shots := 0
+	for range 150 {
+		before, _ := ecs.GetComponent[components.Cannon](w, e)
+		step := before.TimelineStep
+		UpdateCannons(w)
+		after, _ := ecs.GetComponent[components.Cannon](w, e)
+		if after.TimelineStep != step {
+			shots++
+		}
+	}
+
+	// each 50 tick cycle fires both steps, one tick apart: 50, 51, 101, 102
+	if shots != 4 {
+		t.Errorf("fired %d volleys in 150 ticks, want 4", shots)
+	}
+}
@@ -0,0 +1,132 @@
+package systems
+
+import (
+	"reflect"
+	"slices"
+
+	"github.com/game-jam-2026/dead-jump/internal/ecs"
+	"github.com/game-jam-2026/dead-jump/internal/ecs/components"
+	"github.com/game-jam-2026/dead-jump/internal/utils/audio"
+)
+
+const (
+	ownedCollectibleAlpha = 0.35
+	hiddenWallFadeSpeed   = 0.08
+)
+
+// PrepareCollection counts the level's collectibles and marks the ones from
+// owned as already found.
+func PrepareCollection(world *ecs.World, level string, owned []string) {
+	collection := components.Collection{Level: level}
+
+	entities := world.GetEntities(
+		reflect.TypeOf((*components.Collectible)(nil)).Elem(),
+	)
+
+	for _, e := range entities {
+		item, err := ecs.GetComponent[components.Collectible](world, e)
+		if err != nil {
+			continue
+		}
+
+		collection.Total++
+		if !slices.Contains(owned, item.ID) {
+			continue
+		}
+
+		collection.Found = append(collection.Found, item.ID)
+		item.Owned = true
+		world.SetComponent(e, *item)
+		setSpriteAlpha(world, e, true, ownedCollectibleAlpha)
+	}
+
+	// награда выдаётся один раз, повторный проход её не даёт
+	collection.Rewarded = collection.Complete()
+	world.SetResource(collection)
+}
+
+// ApplyCollectibles picks up collectibles the character overlaps. Finding the
+// last one in a level grants an extra life.
+func ApplyCollectibles(world *ecs.World) {
+	entities := world.GetEntities(
+		reflect.TypeOf((*components.Collectible)(nil)).Elem(),
+		reflect.TypeOf((*components.Collision)(nil)).Elem(),
+	)
+
+	for _, e := range entities {
+		item, err := ecs.GetComponent[components.Collectible](world, e)
+		if err != nil {
+			continue
+		}
+
+		if !characterTouches(world, e) {
+			continue
+		}
+
+		world.DestroyEntity(e)
+		audio.Play(audio.SoundPickup)
+
+		collection, err := ecs.GetResource[components.Collection](world)
+		if err != nil || item.Owned || slices.Contains(collection.Found, item.ID) {
+			continue
+		}
+
+		collection.Found = append(collection.Found, item.ID)
+		if collection.Complete() && !collection.Rewarded {
+			collection.Rewarded = true
+			grantLife(world)
+		}
+		world.SetResource(*collection)
+	}
+}
+
+func grantLife(world *ecs.World) {
+	counters := world.GetEntities(
+		reflect.TypeOf((*components.Life)(nil)).Elem(),
+	)
+	if len(counters) == 0 {
+		return
+	}
+
+	life, err := ecs.GetComponent[components.Life](world, counters[0])
+	if err != nil {
+		return
+	}
+	life.Count++
+	world.SetComponent(counters[0], *life)
+}
+
+// UpdateHiddenWalls fades out hidden walls the character has stepped into.
+func UpdateHiddenWalls(world *ecs.World) {
+	entities := world.GetEntities(
+		reflect.TypeOf((*components.HiddenWall)(nil)).Elem(),
+		reflect.TypeOf((*components.Sprite)(nil)).Elem(),
+	)
+
+	for _, e := range entities {
+		wall, err := ecs.GetComponent[components.HiddenWall](world, e)
+		if err != nil {
+			continue
+		}
+
+		if !wall.Revealed && characterTouches(world, e) {
+			wall.Revealed = true
+			world.SetComponent(e, *wall)
+		}
+		if !wall.Revealed {
+			continue
+		}
+
+		sprite, err := ecs.GetComponent[components.Sprite](world, e)
+		if err != nil {
+			continue
+		}
+
+		alpha := sprite.ColorScale.A() - hiddenWallFadeSpeed
+		if alpha <= 0 {
+			world.DestroyEntity(e)
+			continue
+		}
+		setSpriteAlpha(world, e, true, alpha)
+	}
+}
@@ -74,6 +74,10 @@ func ResolvePhysicsCollisions(world *ecs.World, cfg *physics.Config) []Collision
 				}
 				results = append(results, result)
 
+				if isSensor(world, entityA) || isSensor(world, entityB) {
+					continue
+				}
+
 				resolveCollision(
 					world, cfg,
 					entityA, entityB,
@@ -280,6 +284,11 @@ func ApplyProjectileImpulse(world *ecs.World, projectile, target ecs.EntityID, i
 	world.SetComponent(target, *targetBody)
 }
 
+func isSensor(world *ecs.World, e ecs.EntityID) bool {
+	_, err := ecs.GetComponent[components.Sensor](world, e)
+	return err == nil
+}
+
 func resetGroundedState(world *ecs.World) {
 	entities := world.GetEntities(
 		reflect.TypeOf((*components.PhysicsBody)(nil)).Elem(),
@@ -0,0 +1,39 @@
+package systems
+
+import (
+	"reflect"
+
+	"github.com/hajimehoshi/ebiten/v2"
+
+	"github.com/game-jam-2026/dead-jump/internal/ecs"
+	"github.com/game-jam-2026/dead-jump/internal/ecs/components"
+)
+
+func UpdateCorpseFlash(world *ecs.World) {
+	entities := world.GetEntities(
+		reflect.TypeOf((*components.Corpse)(nil)).Elem(),
+		reflect.TypeOf((*components.Sprite)(nil)).Elem(),
+	)
+
+	for _, e := range entities {
+		corpse, err := ecs.GetComponent[components.Corpse](world, e)
+		if err != nil || corpse.FlashTicks <= 0 {
+			continue
+		}
+
+		sprite, err := ecs.GetComponent[components.Sprite](world, e)
+		if err != nil {
+			continue
+		}
+
+		corpse.FlashTicks--
+
+		sprite.ColorScale = ebiten.ColorScale{}
+		if corpse.FlashTicks > 0 && corpse.FlashTicks%4 < 2 {
+			sprite.ColorScale.Scale(2.5, 2.2, 2.2, 1)
+		}
+
+		world.SetComponent(e, *corpse)
+		world.SetComponent(e, *sprite)
+	}
+}
@@ -0,0 +1,200 @@
+package systems
+
+import (
+	"reflect"
+	"slices"
+
+	"github.com/solarlune/resolv"
+
+	"github.com/game-jam-2026/dead-jump/internal/assets"
+	"github.com/game-jam-2026/dead-jump/internal/ecs"
+	"github.com/game-jam-2026/dead-jump/internal/ecs/components"
+	"github.com/game-jam-2026/dead-jump/internal/utils"
+	"github.com/game-jam-2026/dead-jump/internal/utils/audio"
+	"github.com/game-jam-2026/dead-jump/pkg/linalg"
+)
+
+const (
+	// impaleDepth is how far an impaled corpse sinks into the spikes
+	impaleDepth = 3
+	// ragdollImpactScale is how much of a lethal shot's speed the corpse takes
+	ragdollImpactScale = 1.5
+)
+
+// Death describes one death: its cause, the entity that dealt it and, for
+// projectiles, the impact the corpse flies along.
+type Death struct {
+	Cause  components.DeathCause
+	Source ecs.EntityID
+	Impact linalg.Vector2
+
+	// filled in from the body before it dies
+	momentum linalg.Vector2
+	airborne bool
+}
+
+// DeathType is how a cause of death sounds and what it does to the plain
+// corpse left by the death path.
+type DeathType struct {
+	Sound  audio.SoundID
+	Corpse func(world *ecs.World, e ecs.EntityID, death Death)
+}
+
+var deathTypes = map[components.DeathCause]DeathType{
+	components.DeathTouch:      {Sound: audio.SoundDeath},
+	components.DeathSpike:      {Sound: audio.SoundDeath, Corpse: impaleCorpse},
+	components.DeathProjectile: {Sound: audio.SoundDeath, Corpse: ragdollCorpse},
+	components.DeathCrush:      {Sound: audio.SoundDeathCrush, Corpse: flatCorpse},
+	components.DeathFall:       {Sound: audio.SoundDeathFall, Corpse: fallingCorpse},
+	components.DeathLava:       {Sound: audio.SoundDeathBurn, Corpse: noCorpse},
+	components.DeathSacrifice:  {Sound: audio.SoundDeathSacrifice, Corpse: sacrificedCorpse},
+}
+
+// KillCharacter is the death path shared by every hazard: the character
+// turns into a corpse and respawns at the start point. Returns false if e
+// was only an echo.
+func KillCharacter(world *ecs.World, e ecs.EntityID) bool {
+	return KillCharacterWith(world, e, Death{Cause: components.DeathTouch})
+}
+
+// KillCharacterWith kills the character or an echo and shapes what is left
+// of it by the cause of death. Returns false if e was only an echo.
+func KillCharacterWith(world *ecs.World, e ecs.EntityID, death Death) bool {
+	if body, err := ecs.GetComponent[components.PhysicsBody](world, e); err == nil {
+		death.airborne = !body.IsGrounded
+	}
+	if vel, err := ecs.GetComponent[components.Velocity](world, e); err == nil {
+		death.momentum = vel.Vector
+	}
+
+	echo := isEcho(world, e)
+	if echo {
+		_ = world.RemoveComponent(e, components.Echo{})
+		utils.MakeCorpse(world, e, assets.Image(assets.ImageDeadHero), 1)
+	} else {
+		utils.KillEntity(world, e, assets.Image(assets.ImageDeadHero), 1, assets.CreateCharacter)
+		recordDeath(world)
+		respawnRules(world)
+		restartEchoes(world)
+	}
+
+	t := deathTypes[death.Cause]
+	audio.Play(t.Sound)
+	if t.Corpse != nil {
+		t.Corpse(world, e, death)
+	}
+	return !echo
+}
+
+// respawnRules applies the difficulty and assists to the character that
+// just respawned: the grace period and, with infinite lives, the life back.
+func respawnRules(world *ecs.World) {
+	if assist, err := ecs.GetResource[components.Assist](world); err == nil && assist.InfiniteLives {
+		grantLife(world)
+	}
+
+	rules, err := ecs.GetResource[components.DifficultyRules](world)
+	if err != nil || rules.RespawnGrace <= 0 {
+		return
+	}
+	characters := world.GetEntities(reflect.TypeOf((*components.Character)(nil)).Elem())
+	if len(characters) == 0 {
+		return
+	}
+	world.SetComponent(slices.Max(characters), components.Invulnerable{Ticks: rules.RespawnGrace})
+}
+
+// UpdateInvulnerability blinks characters through their respawn grace period
+// and ends it.
+func UpdateInvulnerability(world *ecs.World) {
+	entities := world.GetEntities(
+		reflect.TypeOf((*components.Invulnerable)(nil)).Elem(),
+		reflect.TypeOf((*components.Sprite)(nil)).Elem(),
+	)
+
+	for _, e := range entities {
+		inv, err := ecs.GetComponent[components.Invulnerable](world, e)
+		if err != nil || inv.Ticks <= 0 {
+			continue
+		}
+
+		inv.Ticks--
+		setSpriteAlpha(world, e, inv.Ticks > 0 && inv.Ticks%8 < 4, 0.3)
+		if inv.Ticks == 0 {
+			_ = world.RemoveComponent(e, components.Invulnerable{})
+			continue
+		}
+		world.SetComponent(e, *inv)
+	}
+}
+
+// impaleCorpse lays the corpse on top of the spike that killed it, so it
+// covers the spike for the next life.
+func impaleCorpse(world *ecs.World, e ecs.EntityID, death Death) {
+	spike, err := ecs.GetComponent[components.Collision](world, death.Source)
+	if err != nil {
+		return
+	}
+	sprite, err := ecs.GetComponent[components.Sprite](world, e)
+	if err != nil {
+		return
+	}
+	pos, err := ecs.GetComponent[components.Position](world, e)
+	if err != nil {
+		return
+	}
+
+	width, height := sprite.Size()
+	bounds := spike.Shape.Bounds()
+	pos.Vector.X = min(max(pos.Vector.X, bounds.Min.X), bounds.Max.X-width)
+	pos.Vector.Y = bounds.Min.Y + impaleDepth - height
+
+	world.SetComponent(e, *pos)
+	world.SetComponent(e, components.Collision{
+		Shape: resolv.NewRectangleFromTopLeft(pos.Vector.X, pos.Vector.Y, width, height),
+	})
+}
+
+// ragdollCorpse throws the corpse along the projectile's impact.
+func ragdollCorpse(world *ecs.World, e ecs.EntityID, death Death) {
+	launchCorpse(world, e, death.momentum.Add(death.Impact))
+}
+
+func flatCorpse(world *ecs.World, e ecs.EntityID, _ Death) {
+	flattenCorpse(world, e)
+}
+
+// fallingCorpse keeps falling, unless the level wants nothing left of
+// bodies that fell out of it.
+func fallingCorpse(world *ecs.World, e ecs.EntityID, death Death) {
+	if bounds, err := ecs.GetResource[components.LevelBounds](world); err == nil && bounds.NoFallCorpse {
+		world.DestroyEntity(e)
+		return
+	}
+	launchCorpse(world, e, death.momentum)
+}
+
+func noCorpse(world *ecs.World, e ecs.EntityID, _ Death) {
+	dissolveCorpse(world, e)
+}
+
+// sacrificedCorpse stays where the body stood; in mid-air it keeps the
+// body's momentum and falls until it lands.
+func sacrificedCorpse(world *ecs.World, e ecs.EntityID, death Death) {
+	if death.airborne {
+		launchCorpse(world, e, death.momentum)
+	}
+}
+
+// isInvulnerable reports whether hazards spare e: god mode, a respawn grace
+// period or the invincibility assist.
+func isInvulnerable(world *ecs.World, e ecs.EntityID) bool {
+	if _, err := ecs.GetComponent[components.Invulnerable](world, e); err == nil {
+		return true
+	}
+	if _, err := ecs.GetComponent[components.Character](world, e); err != nil {
+		return false
+	}
+	assist, err := ecs.GetResource[components.Assist](world)
+	return err == nil && assist.Invincible
+}
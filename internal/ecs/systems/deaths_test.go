@@ -0,0 +1,207 @@
+package systems
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/game-jam-2026/dead-jump/internal/assets"
+	"github.com/game-jam-2026/dead-jump/internal/ecs"
+	"github.com/game-jam-2026/dead-jump/internal/ecs/components"
+	"github.com/game-jam-2026/dead-jump/pkg/linalg"
+)
+
+const testLives = 5
+
+// heroWorld is a bare level with a start point, the lives and a character
+// standing at (100, 100).
+func heroWorld(t *testing.T, grounded bool) (*ecs.World, ecs.EntityID) {
+	t.Helper()
+
+	w := ecs.NewWorld()
+	assets.CreateLifeCounter(w, testLives)
+	assets.CreateStartPoint(w, 20, 50)
+	hero := assets.CreateCharacter(w, 100, 100, 1)
+
+	body, err := ecs.GetComponent[components.PhysicsBody](w, hero)
+	if err != nil {
+		t.Fatal(err)
+	}
+	body.IsGrounded = grounded
+	w.SetComponent(hero, *body)
+	w.SetComponent(hero, components.Velocity{Vector: linalg.Vector2{X: 2, Y: 1}})
+	return w, hero
+}
+
+func livesLeft(t *testing.T, w *ecs.World) int {
+	t.Helper()
+	counters := w.GetEntities(reflect.TypeOf((*components.Life)(nil)).Elem())
+	if len(counters) == 0 {
+		t.Fatal("no life counter")
+	}
+	life, err := ecs.GetComponent[components.Life](w, counters[0])
+	if err != nil {
+		t.Fatal(err)
+	}
+	return life.Count
+}
+
+func hasComponent[T any](w *ecs.World, e ecs.EntityID) bool {
+	_, err := ecs.GetComponent[T](w, e)
+	return err == nil
+}
+
+func TestKillCharacterByCause(t *testing.T) {
+	tests := []struct {
+		name     string
+		death    func(w *ecs.World) Death
+		grounded bool
+		noFall   bool
+		check    func(t *testing.T, w *ecs.World, corpse ecs.EntityID)
+	}{
+		{
+			name:     "touch leaves a resting corpse",
+			death:    func(*ecs.World) Death { return Death{Cause: components.DeathTouch} },
+			grounded: true,
+			check: func(t *testing.T, w *ecs.World, corpse ecs.EntityID) {
+				if !hasComponent[components.Corpse](w, corpse) || hasComponent[components.Velocity](w, corpse) {
+					t.Error("want a static corpse")
+				}
+			},
+		},
+		{
+			name: "spike impales the corpse on top of it",
+			death: func(w *ecs.World) Death {
+				spike := assets.CreateSpike(w, 96, 140, components.Repeatable{})
+				return Death{Cause: components.DeathSpike, Source: spike}
+			},
+			grounded: true,
+			check: func(t *testing.T, w *ecs.World, corpse ecs.EntityID) {
+				spikes := w.GetEntities(reflect.TypeOf((*components.Spike)(nil)).Elem())
+				spikeCol, _ := ecs.GetComponent[components.Collision](w, spikes[0])
+				col, _ := ecs.GetComponent[components.Collision](w, corpse)
+				want := spikeCol.Shape.Bounds().Min.Y + impaleDepth
+				if got := col.Shape.Bounds().Max.Y; got != want {
+					t.Errorf("corpse bottom = %v, want %v", got, want)
+				}
+			},
+		},
+		{
+			name: "projectile throws the corpse along the impact",
+			death: func(*ecs.World) Death {
+				return Death{Cause: components.DeathProjectile, Impact: linalg.Vector2{X: 6}}
+			},
+			grounded: true,
+			check: func(t *testing.T, w *ecs.World, corpse ecs.EntityID) {
+				vel, err := ecs.GetComponent[components.Velocity](w, corpse)
+				if err != nil {
+					t.Fatal("corpse wasn't launched")
+				}
+				if want := (linalg.Vector2{X: 8, Y: 1}); vel.Vector != want {
+					t.Errorf("corpse velocity = %v, want %v", vel.Vector, want)
+				}
+			},
+		},
+		{
+			name:     "crush flattens the corpse",
+			death:    func(*ecs.World) Death { return Death{Cause: components.DeathCrush} },
+			grounded: true,
+			check: func(t *testing.T, w *ecs.World, corpse ecs.EntityID) {
+				sprite, _ := ecs.GetComponent[components.Sprite](w, corpse)
+				if scale := sprite.EffectiveScale(); scale.Y >= 1 {
+					t.Errorf("corpse scale = %v, want it flattened", scale)
+				}
+			},
+		},
+		{
+			name:  "fall keeps the corpse falling",
+			death: func(*ecs.World) Death { return Death{Cause: components.DeathFall} },
+			check: func(t *testing.T, w *ecs.World, corpse ecs.EntityID) {
+				if !hasComponent[components.Velocity](w, corpse) {
+					t.Error("want a falling corpse")
+				}
+			},
+		},
+		{
+			name:   "fall out of a level without fall corpses",
+			death:  func(*ecs.World) Death { return Death{Cause: components.DeathFall} },
+			noFall: true,
+			check: func(t *testing.T, w *ecs.World, corpse ecs.EntityID) {
+				if hasComponent[components.Position](w, corpse) {
+					t.Error("want the corpse removed")
+				}
+			},
+		},
+		{
+			name:     "lava leaves nothing to stand on",
+			death:    func(*ecs.World) Death { return Death{Cause: components.DeathLava} },
+			grounded: true,
+			check: func(t *testing.T, w *ecs.World, corpse ecs.EntityID) {
+				if hasComponent[components.Corpse](w, corpse) || !hasComponent[components.Fade](w, corpse) {
+					t.Error("want a fading body without a corpse")
+				}
+			},
+		},
+		{
+			name:     "sacrifice on the ground stays put",
+			death:    func(*ecs.World) Death { return Death{Cause: components.DeathSacrifice} },
+			grounded: true,
+			check: func(t *testing.T, w *ecs.World, corpse ecs.EntityID) {
+				if hasComponent[components.Velocity](w, corpse) {
+					t.Error("want a static corpse")
+				}
+			},
+		},
+		{
+			name:  "sacrifice in mid-air keeps the momentum",
+			death: func(*ecs.World) Death { return Death{Cause: components.DeathSacrifice} },
+			check: func(t *testing.T, w *ecs.World, corpse ecs.EntityID) {
+				vel, err := ecs.GetComponent[components.Velocity](w, corpse)
+				if err != nil {
+					t.Fatal("corpse wasn't launched")
+				}
+				if want := (linalg.Vector2{X: 2, Y: 1}); vel.Vector != want {
+					t.Errorf("corpse velocity = %v, want %v", vel.Vector, want)
+				}
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			w, hero := heroWorld(t, tt.grounded)
+			bounds := components.NewLevelBounds(320, 240)
+			bounds.NoFallCorpse = tt.noFall
+			w.SetResource(bounds)
+
+			if !KillCharacterWith(w, hero, tt.death(w)) {
+				t.Fatal("character death reported as an echo")
+			}
+
+			if got := livesLeft(t, w); got != testLives-1 {
+				t.Errorf("lives = %d, want %d", got, testLives-1)
+			}
+			if hasComponent[components.Character](w, hero) {
+				t.Error("the dead body is still the character")
+			}
+			if characters := w.GetEntities(reflect.TypeOf((*components.Character)(nil)).Elem()); len(characters) != 1 {
+				t.Errorf("%d characters after respawn, want 1", len(characters))
+			}
+			tt.check(t, w, hero)
+		})
+	}
+}
+
+func TestKillEcho(t *testing.T) {
+	w, _ := heroWorld(t, true)
+	echo := assets.CreateEcho(w, 60, 100, nil)
+
+	if KillCharacterWith(w, echo, Death{Cause: components.DeathSpike}) {
+		t.Error("echo death reported as the character's")
+	}
+	if got := livesLeft(t, w); got != testLives {
+		t.Errorf("lives = %d, want %d", got, testLives)
+	}
+	if hasComponent[components.Echo](w, echo) || !hasComponent[components.Corpse](w, echo) {
+		t.Error("want the echo turned into a corpse")
+	}
+}
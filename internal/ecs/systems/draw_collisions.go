@@ -11,7 +11,7 @@ import (
 	"github.com/hajimehoshi/ebiten/v2/vector"
 )
 
-func DrawCollisions(world *ecs.World, screen *ebiten.Image) {
+func DrawCollisions(world *ecs.World, screen *ebiten.Image, camera *components.Camera) {
 	entities := world.GetEntities(
 		reflect.TypeOf((*components.Position)(nil)).Elem(),
 		reflect.TypeOf((*components.Collision)(nil)).Elem(),
@@ -31,7 +31,9 @@ func DrawCollisions(world *ecs.World, screen *ebiten.Image) {
 		width := float32(bounds.Width())
 		height := float32(bounds.Height())
 
+		screenPos := camera.WorldToScreen(pos.Vector)
+
 		clr := color.RGBA{R: 0, G: 255, B: 0, A: 255}
-		vector.StrokeRect(screen, float32(pos.Vector.X), float32(pos.Vector.Y), width, height, 1, clr, false)
+		vector.StrokeRect(screen, float32(screenPos.X), float32(screenPos.Y), width, height, 1, clr, false)
 	}
 }
@@ -0,0 +1,132 @@
+package systems
+
+import (
+	"fmt"
+	"image/color"
+	"reflect"
+
+	"github.com/game-jam-2026/dead-jump/internal/ecs"
+	"github.com/game-jam-2026/dead-jump/internal/ecs/components"
+	"github.com/game-jam-2026/dead-jump/internal/physics"
+	"github.com/game-jam-2026/dead-jump/pkg/linalg"
+
+	"github.com/hajimehoshi/ebiten/v2"
+	"github.com/hajimehoshi/ebiten/v2/ebitenutil"
+	"github.com/hajimehoshi/ebiten/v2/vector"
+)
+
+const (
+	// velocity is drawn scaled so that slow bodies are still visible
+	debugVelocityScale = 4.0
+	debugNormalLength  = 10.0
+)
+
+var (
+	debugVelocityColor = color.RGBA{R: 255, G: 80, B: 80, A: 255}
+	debugNormalColor   = color.RGBA{R: 80, G: 160, B: 255, A: 255}
+	debugContactColor  = color.RGBA{R: 255, G: 220, B: 0, A: 255}
+	debugDeadZoneColor = color.RGBA{R: 255, G: 0, B: 255, A: 160}
+)
+
+// DrawDebugOverlay draws physics state on top of the world: collision bounds,
+// velocities, ground normals, body flags, surface contacts, the camera dead
+// zone and per-tick stats.
+func DrawDebugOverlay(world *ecs.World, screen *ebiten.Image, camera *components.Camera) {
+	DrawCollisions(world, screen, camera)
+
+	entities := world.GetEntities(
+		reflect.TypeOf((*components.Position)(nil)).Elem(),
+		reflect.TypeOf((*components.Collision)(nil)).Elem(),
+	)
+
+	for _, e := range entities {
+		pos, err := ecs.GetComponent[components.Position](world, e)
+		if err != nil {
+			continue
+		}
+		collision, err := ecs.GetComponent[components.Collision](world, e)
+		if err != nil {
+			continue
+		}
+
+		bounds := collision.Shape.Bounds()
+		screenPos := camera.WorldToScreen(pos.Vector)
+		center := screenPos.Add(linalg.Vector2{X: bounds.Width() / 2, Y: bounds.Height() / 2})
+
+		flags := fmt.Sprint(e)
+
+		if vel, err := ecs.GetComponent[components.Velocity](world, e); err == nil && !vel.Vector.IsZero() {
+			drawDebugLine(screen, center, center.Add(vel.Vector.Scale(debugVelocityScale)), debugVelocityColor)
+		}
+
+		if body, err := ecs.GetComponent[components.PhysicsBody](world, e); err == nil && !body.IsStatic() {
+			if body.IsGrounded {
+				flags += " G"
+				bottom := linalg.Vector2{X: center.X, Y: screenPos.Y + bounds.Height()}
+				drawDebugLine(screen, bottom, bottom.Add(body.GroundNormal.Scale(debugNormalLength)), debugNormalColor)
+			}
+
+			if surfaceEntity, _, ok := findContactSurfaceEntity(world, e); ok {
+				drawDebugContact(world, screen, camera, surfaceEntity, center)
+			}
+		}
+
+		if proj, err := ecs.GetComponent[components.Projectile](world, e); err == nil && proj.IsStationary {
+			flags += " S"
+		}
+
+		ebitenutil.DebugPrintAt(screen, flags, int(screenPos.X), int(screenPos.Y)-14)
+	}
+
+	drawDebugDeadZone(screen, camera)
+	drawDebugStats(world, screen)
+}
+
+func drawDebugContact(world *ecs.World, screen *ebiten.Image, camera *components.Camera, surfaceEntity ecs.EntityID, from linalg.Vector2) {
+	pos, err := ecs.GetComponent[components.Position](world, surfaceEntity)
+	if err != nil {
+		return
+	}
+	collision, err := ecs.GetComponent[components.Collision](world, surfaceEntity)
+	if err != nil {
+		return
+	}
+
+	bounds := collision.Shape.Bounds()
+	screenPos := camera.WorldToScreen(pos.Vector)
+	vector.StrokeRect(screen, float32(screenPos.X), float32(screenPos.Y), float32(bounds.Width()), float32(bounds.Height()), 2, debugContactColor, false)
+
+	to := linalg.Vector2{X: from.X, Y: screenPos.Y}
+	drawDebugLine(screen, from, to, debugContactColor)
+}
+
+// drawDebugDeadZone draws the dead zone around the viewport center, i.e. where
+// the camera target can move without the camera following it.
+func drawDebugDeadZone(screen *ebiten.Image, camera *components.Camera) {
+	center := camera.WorldToScreen(camera.Position.Add(linalg.Vector2{
+		X: camera.ViewportWidth / 2,
+		Y: camera.ViewportHeight / 2,
+	}))
+
+	vector.StrokeRect(screen,
+		float32(center.X-camera.DeadZoneX), float32(center.Y-camera.DeadZoneY),
+		float32(camera.DeadZoneX*2), float32(camera.DeadZoneY*2),
+		1, debugDeadZoneColor, false)
+}
+
+func drawDebugStats(world *ecs.World, screen *ebiten.Image) {
+	stats := &physics.Stats{}
+	if s, err := ecs.GetResource[physics.Stats](world); err == nil {
+		stats = s
+	}
+
+	msg := fmt.Sprintf("FPS %.0f TPS %.0f\ncollisions %d substeps %d",
+		ebiten.ActualFPS(), ebiten.ActualTPS(), stats.Collisions, stats.Substeps)
+
+	bounds := screen.Bounds()
+	ebitenutil.DebugPrintAt(screen, msg, 4, bounds.Dy()-34)
+}
+
+func drawDebugLine(screen *ebiten.Image, from, to linalg.Vector2, clr color.Color) {
+	vector.StrokeLine(screen, float32(from.X), float32(from.Y), float32(to.X), float32(to.Y), 1, clr, false)
+}
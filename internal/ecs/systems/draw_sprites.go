@@ -34,8 +34,9 @@ func DrawSprites(world *ecs.World, screen *ebiten.Image) {
 			continue
 		}
 
-		op := &ebiten.DrawImageOptions{}
-		op.GeoM.Translate(pos.Vector.X, pos.Vector.Y)
-		screen.DrawImage(sprite.Image, op)
+		if sprite.Image == nil {
+			continue
+		}
+		screen.DrawImage(sprite.Image, sprite.DrawOptions(pos.Vector))
 	}
 }
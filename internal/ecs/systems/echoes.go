@@ -0,0 +1,120 @@
+package systems
+
+import (
+	"reflect"
+
+	"github.com/game-jam-2026/dead-jump/internal/assets"
+	"github.com/game-jam-2026/dead-jump/internal/ecs"
+	"github.com/game-jam-2026/dead-jump/internal/ecs/components"
+)
+
+// RecordLife appends this tick's input to the life being played, if the
+// level runs in the echoes mode. Sacrifice presses are left out: the level
+// may have refused them, and an accepted one ends the life anyway.
+func RecordLife(world *ecs.World) {
+	echoes, err := ecs.GetResource[components.Echoes](world)
+	if err != nil {
+		return
+	}
+
+	var held components.Button
+	if in, err := ecs.GetResource[components.Input](world); err == nil {
+		held = in.Held &^ components.ButtonSacrifice
+	}
+
+	echoes.Current = append(echoes.Current, held)
+	world.SetResource(*echoes)
+}
+
+// MoveEchoes drives every echo with its next recorded input. An echo that
+// has replayed its whole life dies again where it stands, which is how a
+// sacrificed life ends too.
+func MoveEchoes(world *ecs.World) {
+	entities := world.GetEntities(
+		reflect.TypeOf((*components.Echo)(nil)).Elem(),
+		reflect.TypeOf((*components.PhysicsBody)(nil)).Elem(),
+		reflect.TypeOf((*components.Velocity)(nil)).Elem(),
+	)
+
+	for _, e := range entities {
+		echo, err := ecs.GetComponent[components.Echo](world, e)
+		if err != nil {
+			continue
+		}
+
+		if echo.Done() {
+			sacrifice(world, e)
+			continue
+		}
+
+		drive(world, e, echo.Input())
+		echo.Tick++
+		world.SetComponent(e, *echo)
+	}
+}
+
+// restartEchoes closes the life that just ended and sends every lost life
+// of the level out again from the start point, alongside the new one.
+func restartEchoes(world *ecs.World) {
+	echoes, err := ecs.GetResource[components.Echoes](world)
+	if err != nil {
+		return
+	}
+
+	echoes.Lives = append(echoes.Lives, echoes.Current)
+	echoes.Current = nil
+	world.SetResource(*echoes)
+
+	for _, e := range world.GetEntities(reflect.TypeOf((*components.Echo)(nil)).Elem()) {
+		world.DestroyEntity(e)
+	}
+
+	startPoints := world.GetEntities(reflect.TypeOf((*components.StartPoint)(nil)).Elem())
+	if len(startPoints) == 0 {
+		return
+	}
+	pos, err := ecs.GetComponent[components.Position](world, startPoints[0])
+	if err != nil {
+		return
+	}
+
+	for _, inputs := range echoes.Lives {
+		assets.CreateEcho(world, pos.Vector.X, pos.Vector.Y, inputs)
+	}
+}
+
+// dropLastInput takes the tick the life ended on out of its recording, so
+// the echo dies on the same tick the character did.
+func dropLastInput(world *ecs.World) {
+	echoes, err := ecs.GetResource[components.Echoes](world)
+	if err != nil || len(echoes.Current) == 0 {
+		return
+	}
+	echoes.Current = echoes.Current[:len(echoes.Current)-1]
+	world.SetResource(*echoes)
+}
+
+func isEcho(world *ecs.World, e ecs.EntityID) bool {
+	_, err := ecs.GetComponent[components.Echo](world, e)
+	return err == nil
+}
+
+// isMortal reports whether hazards kill e: the character or an echo.
+func isMortal(world *ecs.World, e ecs.EntityID) bool {
+	if _, err := ecs.GetComponent[components.Character](world, e); err == nil {
+		return true
+	}
+	return isEcho(world, e)
+}
+
+// passThrough reports whether a and b are an echo and another hero body;
+// they overlap at the start point, so they never push each other.
+func passThrough(world *ecs.World, a, b ecs.EntityID) bool {
+	if isEcho(world, a) {
+		return isMortal(world, b)
+	}
+	if isEcho(world, b) {
+		return isMortal(world, a)
+	}
+	return false
+}
@@ -0,0 +1,80 @@
+package systems
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/game-jam-2026/dead-jump/internal/ecs"
+	"github.com/game-jam-2026/dead-jump/internal/ecs/components"
+)
+
+// press runs the input part of a tick: the echoes recording and the
+// sacrifice rule.
+func press(w *ecs.World, held components.Button) bool {
+	var previous components.Button
+	if in, err := ecs.GetResource[components.Input](w); err == nil {
+		previous = in.Held
+	}
+	w.SetResource(components.Input{Held: held, Pressed: held &^ previous})
+	RecordLife(w)
+	return ApplySacrifice(w)
+}
+
+func TestEchoesSkipRefusedSacrifices(t *testing.T) {
+	tests := []struct {
+		name  string
+		rule  components.Sacrifices
+		taken bool
+	}{
+		{"disabled", components.Sacrifices{Disabled: true}, false},
+		{"used up", components.Sacrifices{Limit: 1, Used: 1}, false},
+		{"allowed", components.Sacrifices{Limit: 1}, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			w, _ := heroWorld(t, true)
+			w.SetResource(components.Echoes{})
+			w.SetResource(tt.rule)
+
+			press(w, components.ButtonRight)
+			press(w, components.ButtonRight)
+			if got := press(w, components.ButtonRight|components.ButtonSacrifice); got != tt.taken {
+				t.Fatalf("ApplySacrifice() = %v, want %v", got, tt.taken)
+			}
+
+			echoes, _ := ecs.GetResource[components.Echoes](w)
+			if !tt.taken {
+				for i, held := range echoes.Current {
+					if held&components.ButtonSacrifice != 0 {
+						t.Errorf("tick %d recorded a refused sacrifice", i)
+					}
+				}
+				if n := len(w.GetEntities(reflect.TypeOf((*components.Echo)(nil)).Elem())); n != 0 {
+					t.Errorf("%d echoes without a lost life", n)
+				}
+				return
+			}
+
+			// the sacrificed life ends with its last tick, so the echo dies
+			// on the same tick the character did
+			if len(echoes.Lives) != 1 || len(echoes.Lives[0]) != 2 {
+				t.Fatalf("recorded lives %v, want one of 2 ticks", echoes.Lives)
+			}
+			echo := w.GetEntities(reflect.TypeOf((*components.Echo)(nil)).Elem())
+			if len(echo) != 1 {
+				t.Fatalf("%d echoes, want 1", len(echo))
+			}
+			for range 2 {
+				MoveEchoes(w)
+			}
+			if !isEcho(w, echo[0]) {
+				t.Fatal("echo died before the end of its life")
+			}
+			MoveEchoes(w)
+			if isEcho(w, echo[0]) {
+				t.Error("echo outlived its life")
+			}
+		})
+	}
+}
@@ -0,0 +1,241 @@
+package systems
+
+import (
+	"math"
+	"reflect"
+
+	"github.com/solarlune/resolv"
+
+	"github.com/game-jam-2026/dead-jump/internal/ecs"
+	"github.com/game-jam-2026/dead-jump/internal/ecs/components"
+	"github.com/game-jam-2026/dead-jump/pkg/linalg"
+)
+
+// BehaviorPatrol moves towards the next patrol point; flying entities move
+// on both axes, walkers only horizontally.
+func BehaviorPatrol(world *ecs.World, e ecs.EntityID, brain *components.Brain) {
+	patrol, err := ecs.GetComponent[components.Patrol](world, e)
+	if err != nil || len(patrol.Points) == 0 {
+		return
+	}
+
+	center, ok := entityCenter(world, e)
+	if !ok {
+		return
+	}
+
+	patrol.Next %= len(patrol.Points)
+	target := patrol.Points[patrol.Next]
+
+	offset := target.Sub(center)
+	if !isFlying(world, e) {
+		offset.Y = 0
+	}
+
+	if offset.Length() <= patrol.Speed {
+		patrol.Next = (patrol.Next + 1) % len(patrol.Points)
+		world.SetComponent(e, *patrol)
+	}
+
+	moveEnemy(world, e, brain, offset.Normalized().Scale(patrol.Speed))
+}
+
+// BehaviorWalkEdges walks forward and turns around at walls and ledges.
+func BehaviorWalkEdges(world *ecs.World, e ecs.EntityID, brain *components.Brain) {
+	walker, err := ecs.GetComponent[components.Walker](world, e)
+	if err != nil {
+		return
+	}
+
+	body, err := ecs.GetComponent[components.PhysicsBody](world, e)
+	if err != nil {
+		return
+	}
+
+	if wallAhead(world, e, brain.Facing) || (body.IsGrounded && !groundAhead(world, e, brain.Facing)) {
+		brain.Facing = -brain.Facing
+	}
+
+	moveEnemy(world, e, brain, linalg.Vector2{X: walker.Speed * brain.Facing})
+}
+
+// BehaviorFlySine flies back and forth around the origin. The height is
+// pulled towards the sine curve rather than set, so coming back from a chase
+// is smooth.
+func BehaviorFlySine(world *ecs.World, e ecs.EntityID, brain *components.Brain) {
+	mover, err := ecs.GetComponent[components.SineMover](world, e)
+	if err != nil {
+		return
+	}
+
+	center, ok := entityCenter(world, e)
+	if !ok {
+		return
+	}
+
+	mover.Ticks++
+	world.SetComponent(e, *mover)
+
+	dx := center.X - mover.Origin.X
+	if (dx > mover.Range && brain.Facing > 0) || (dx < -mover.Range && brain.Facing < 0) {
+		brain.Facing = -brain.Facing
+	}
+
+	phase := 2 * math.Pi * float64(mover.Ticks) / float64(max(1, mover.Period))
+	targetY := mover.Origin.Y + mover.Amplitude*math.Sin(phase)
+
+	moveEnemy(world, e, brain, linalg.Vector2{
+		X: mover.Speed * brain.Facing,
+		Y: clamp((targetY-center.Y)*0.2, -mover.Speed*2, mover.Speed*2),
+	})
+}
+
+// BehaviorChase goes straight at the character.
+func BehaviorChase(world *ecs.World, e ecs.EntityID, brain *components.Brain) {
+	chaser, err := ecs.GetComponent[components.Chaser](world, e)
+	if err != nil {
+		return
+	}
+
+	target, ok := findCannonTarget(world)
+	if !ok {
+		return
+	}
+
+	center, ok := entityCenter(world, e)
+	if !ok {
+		return
+	}
+
+	offset := target.Sub(center)
+	if !isFlying(world, e) {
+		offset.Y = 0
+		if wallAhead(world, e, math.Copysign(1, offset.X)) {
+			offset.X = 0
+		}
+	}
+
+	moveEnemy(world, e, brain, offset.Normalized().Scale(chaser.Speed))
+}
+
+// SeesTarget reports whether the character is within the chaser's sight
+// range with no solid shape in between.
+func SeesTarget(world *ecs.World, e ecs.EntityID, _ *components.Brain) bool {
+	chaser, err := ecs.GetComponent[components.Chaser](world, e)
+	if err != nil {
+		return false
+	}
+
+	target, ok := findCannonTarget(world)
+	if !ok {
+		return false
+	}
+
+	center, ok := entityCenter(world, e)
+	if !ok || target.Sub(center).Length() > chaser.SightRange {
+		return false
+	}
+
+	return lineOfSight(world, e, center, target)
+}
+
+// LostTarget fires once the character has been out of sight for the chaser's
+// GiveUpTicks.
+func LostTarget(world *ecs.World, e ecs.EntityID, brain *components.Brain) bool {
+	chaser, err := ecs.GetComponent[components.Chaser](world, e)
+	if err != nil {
+		return true
+	}
+	return brain.LostTicks >= chaser.GiveUpTicks
+}
+
+// moveEnemy sets the velocity directly; walkers keep their vertical velocity
+// so gravity still applies.
+func moveEnemy(world *ecs.World, e ecs.EntityID, brain *components.Brain, v linalg.Vector2) {
+	vel, err := ecs.GetComponent[components.Velocity](world, e)
+	if err != nil {
+		return
+	}
+
+	vel.Vector.X = v.X
+	if isFlying(world, e) {
+		vel.Vector.Y = v.Y
+	}
+	world.SetComponent(e, *vel)
+
+	if v.X > 0.01 {
+		brain.Facing = 1
+	} else if v.X < -0.01 {
+		brain.Facing = -1
+	}
+}
+
+func isFlying(world *ecs.World, e ecs.EntityID) bool {
+	body, err := ecs.GetComponent[components.PhysicsBody](world, e)
+	return err == nil && body.GravityScale == 0
+}
+
+func wallAhead(world *ecs.World, e ecs.EntityID, facing float64) bool {
+	col, err := ecs.GetComponent[components.Collision](world, e)
+	if err != nil {
+		return false
+	}
+
+	b := col.Shape.Bounds()
+	x := b.Max.X + 1
+	if facing < 0 {
+		x = b.Min.X - 3
+	}
+	probe := resolv.NewRectangleFromTopLeft(x, b.Min.Y+2, 2, b.Height()-4)
+	return touchesSolid(world, e, probe)
+}
+
+func groundAhead(world *ecs.World, e ecs.EntityID, facing float64) bool {
+	col, err := ecs.GetComponent[components.Collision](world, e)
+	if err != nil {
+		return true
+	}
+
+	b := col.Shape.Bounds()
+	x := b.Max.X + 1
+	if facing < 0 {
+		x = b.Min.X - 3
+	}
+	probe := resolv.NewRectangleFromTopLeft(x, b.Max.Y+1, 2, 4)
+	return touchesSolid(world, e, probe)
+}
+
+func lineOfSight(world *ecs.World, e ecs.EntityID, from, to linalg.Vector2) bool {
+	line := resolv.NewLine(from.X, from.Y, to.X, to.Y)
+	return !touchesSolid(world, e, line)
+}
+
+// touchesSolid checks the shape against static colliders; characters,
+// enemies and sensors are never solid for probes.
+func touchesSolid(world *ecs.World, self ecs.EntityID, shape resolv.IShape) bool {
+	entities := world.GetEntities(
+		reflect.TypeOf((*components.Collision)(nil)).Elem(),
+		reflect.TypeOf((*components.PhysicsBody)(nil)).Elem(),
+	)
+
+	for _, other := range entities {
+		if other == self || isSensor(world, other) {
+			continue
+		}
+
+		body, err := ecs.GetComponent[components.PhysicsBody](world, other)
+		if err != nil || !body.IsStatic() {
+			continue
+		}
+
+		col, err := ecs.GetComponent[components.Collision](world, other)
+		if err != nil {
+			continue
+		}
+
+		if shape.IsIntersecting(col.Shape) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,161 @@
+package systems
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+
+	"github.com/game-jam-2026/dead-jump/internal/ecs"
+	"github.com/game-jam-2026/dead-jump/internal/ecs/components"
+)
+
+// EnemyBehavior moves the entity for one tick of its current state.
+type EnemyBehavior func(world *ecs.World, e ecs.EntityID, brain *components.Brain)
+
+// EnemyCondition decides whether a transition fires.
+type EnemyCondition func(world *ecs.World, e ecs.EntityID, brain *components.Brain) bool
+
+type EnemyTransition struct {
+	When EnemyCondition
+	To   string
+}
+
+type EnemyState struct {
+	Behavior    EnemyBehavior
+	Transitions []EnemyTransition
+}
+
+type EnemyMachine struct {
+	Initial string
+	States  map[string]EnemyState
+}
+
+var enemyMachines = make(map[string]EnemyMachine)
+
+func RegisterEnemyMachine(name string, m EnemyMachine) {
+	enemyMachines[name] = m
+}
+
+func init() {
+	RegisterEnemyMachine(components.MachinePatrol, EnemyMachine{
+		Initial: "patrol",
+		States: map[string]EnemyState{
+			"patrol": {Behavior: BehaviorPatrol},
+		},
+	})
+	RegisterEnemyMachine(components.MachineWalker, EnemyMachine{
+		Initial: "walk",
+		States: map[string]EnemyState{
+			"walk": {Behavior: BehaviorWalkEdges},
+		},
+	})
+	RegisterEnemyMachine(components.MachineFlyer, EnemyMachine{
+		Initial: "fly",
+		States: map[string]EnemyState{
+			"fly": {Behavior: BehaviorFlySine},
+		},
+	})
+	RegisterEnemyMachine(components.MachineGuard, EnemyMachine{
+		Initial: "patrol",
+		States: map[string]EnemyState{
+			"patrol": {
+				Behavior:    BehaviorPatrol,
+				Transitions: []EnemyTransition{{When: SeesTarget, To: "chase"}},
+			},
+			"chase": {
+				Behavior:    BehaviorChase,
+				Transitions: []EnemyTransition{{When: LostTarget, To: "patrol"}},
+			},
+		},
+	})
+	RegisterEnemyMachine(components.MachineBat, EnemyMachine{
+		Initial: "fly",
+		States: map[string]EnemyState{
+			"fly": {
+				Behavior:    BehaviorFlySine,
+				Transitions: []EnemyTransition{{When: SeesTarget, To: "chase"}},
+			},
+			"chase": {
+				Behavior:    BehaviorChase,
+				Transitions: []EnemyTransition{{When: LostTarget, To: "fly"}},
+			},
+		},
+	})
+}
+
+// CheckEnemies reports the enemies of a loaded level whose machine isn't
+// registered; UpdateEnemies leaves them standing.
+func CheckEnemies(world *ecs.World) error {
+	var errs []error
+	for _, e := range world.GetEntities(reflect.TypeOf((*components.Brain)(nil)).Elem()) {
+		brain, err := ecs.GetComponent[components.Brain](world, e)
+		if err != nil {
+			continue
+		}
+		if _, ok := enemyMachines[brain.Machine]; !ok {
+			errs = append(errs, fmt.Errorf("entity %d: unknown machine %q", e, brain.Machine))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// UpdateEnemies runs one tick of every Brain: the first matching transition
+// of the current state fires, then the state's behavior runs.
+func UpdateEnemies(world *ecs.World) {
+	entities := world.GetEntities(
+		reflect.TypeOf((*components.Brain)(nil)).Elem(),
+	)
+
+	for _, e := range entities {
+		brain, err := ecs.GetComponent[components.Brain](world, e)
+		if err != nil {
+			continue
+		}
+
+		// unknown machines are reported by CheckEnemies when the level loads
+		machine, ok := enemyMachines[brain.Machine]
+		if !ok {
+			continue
+		}
+
+		if brain.Facing == 0 {
+			brain.Facing = 1
+		}
+
+		if _, err := ecs.GetComponent[components.Chaser](world, e); err == nil {
+			if SeesTarget(world, e, brain) {
+				brain.LostTicks = 0
+			} else {
+				brain.LostTicks++
+			}
+		}
+
+		state, ok := machine.States[brain.State]
+		if !ok {
+			brain.State = machine.Initial
+			brain.StateTicks = 0
+			state = machine.States[brain.State]
+		}
+
+		for _, t := range state.Transitions {
+			if t.When(world, e, brain) {
+				brain.State = t.To
+				brain.StateTicks = 0
+				state = machine.States[brain.State]
+				break
+			}
+		}
+
+		if state.Behavior != nil {
+			state.Behavior(world, e, brain)
+		}
+		brain.StateTicks++
+
+		world.SetComponent(e, *brain)
+
+		if sprite, err := ecs.GetComponent[components.Sprite](world, e); err == nil {
+			sprite.FlipX = brain.Facing < 0
+			world.SetComponent(e, *sprite)
+		}
+	}
+}
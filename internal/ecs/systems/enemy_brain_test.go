@@ -0,0 +1,46 @@
+package systems
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/game-jam-2026/dead-jump/internal/ecs"
+	"github.com/game-jam-2026/dead-jump/internal/ecs/components"
+)
+
+func TestCheckEnemies(t *testing.T) {
+	tests := []struct {
+		name     string
+		machines []string
+		unknown  []string
+	}{
+		{"no enemies", nil, nil},
+		{"registered", []string{components.MachinePatrol, components.MachineBat}, nil},
+		{"unknown", []string{components.MachineGuard, "gaurd", "ghost"}, []string{`"gaurd"`, `"ghost"`}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			w := ecs.NewWorld()
+			for _, machine := range tt.machines {
+				w.SetComponent(w.CreateEntity(), components.Brain{Machine: machine})
+			}
+
+			err := CheckEnemies(w)
+			if len(tt.unknown) == 0 {
+				if err != nil {
+					t.Errorf("CheckEnemies() = %v, want nil", err)
+				}
+				return
+			}
+			if err == nil {
+				t.Fatal("CheckEnemies() = nil, want an error")
+			}
+			for _, name := range tt.unknown {
+				if !strings.Contains(err.Error(), name) {
+					t.Errorf("error %q doesn't name %s", err, name)
+				}
+			}
+		})
+	}
+}
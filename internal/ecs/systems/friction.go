@@ -123,14 +123,22 @@ func ApplyAccumulated(world *ecs.World, cfg *physics.Config) {
 }
 
 func findContactSurface(world *ecs.World, entity ecs.EntityID) *components.Surface {
+	_, surface, ok := findContactSurfaceEntity(world, entity)
+	if !ok {
+		return nil
+	}
+	return surface
+}
+
+func findContactSurfaceEntity(world *ecs.World, entity ecs.EntityID) (ecs.EntityID, *components.Surface, bool) {
 	col, err := ecs.GetComponent[components.Collision](world, entity)
 	if err != nil {
-		return nil
+		return 0, nil, false
 	}
 
 	pos, err := ecs.GetComponent[components.Position](world, entity)
 	if err != nil {
-		return nil
+		return 0, nil, false
 	}
 
 	entityBounds := col.Shape.Bounds()
@@ -172,10 +180,10 @@ func findContactSurface(world *ecs.World, entity ecs.EntityID) *components.Surfa
 		if horizontalOverlap && verticalContact {
 			surface, err := ecs.GetComponent[components.Surface](world, se)
 			if err == nil {
-				return surface
+				return se, surface, true
 			}
 		}
 	}
 
-	return nil
+	return 0, nil, false
 }
@@ -0,0 +1,98 @@
+package systems
+
+import (
+	"reflect"
+	"slices"
+
+	"github.com/game-jam-2026/dead-jump/internal/ecs"
+	"github.com/game-jam-2026/dead-jump/internal/ecs/components"
+	"github.com/game-jam-2026/dead-jump/pkg/linalg"
+)
+
+const (
+	GhostAlpha     = 0.4
+	ghostFadeTicks = 30
+)
+
+// RecordGhost appends the character's current frame to the level's ghost
+// recording, if the level keeps one.
+func RecordGhost(world *ecs.World) {
+	recording, err := ecs.GetResource[components.GhostRecording](world)
+	if err != nil {
+		return
+	}
+
+	characters := world.GetEntities(
+		reflect.TypeOf((*components.Character)(nil)).Elem(),
+		reflect.TypeOf((*components.Position)(nil)).Elem(),
+	)
+	if len(characters) == 0 {
+		return
+	}
+	e := slices.Min(characters)
+
+	pos, err := ecs.GetComponent[components.Position](world, e)
+	if err != nil {
+		return
+	}
+
+	frame := components.GhostFrame{X: pos.Vector.X, Y: pos.Vector.Y}
+	if animator, err := ecs.GetComponent[components.CharacterAnimator](world, e); err == nil {
+		frame.State = animator.State
+		frame.StateTicks = animator.StateTicks
+		frame.FacingLeft = animator.FacingLeft
+	}
+
+	recording.Frames = append(recording.Frames, frame)
+	world.SetResource(*recording)
+}
+
+// UpdateGhosts moves ghosts to their next recorded frame; a ghost that ran
+// out of frames fades away.
+func UpdateGhosts(world *ecs.World) {
+	entities := world.GetEntities(
+		reflect.TypeOf((*components.Ghost)(nil)).Elem(),
+		reflect.TypeOf((*components.CharacterAnimator)(nil)).Elem(),
+	)
+
+	for _, e := range entities {
+		ghost, err := ecs.GetComponent[components.Ghost](world, e)
+		if err != nil {
+			continue
+		}
+
+		if ghost.Tick >= len(ghost.Frames) {
+			if _, err := ecs.GetComponent[components.Fade](world, e); err != nil {
+				world.SetComponent(e, components.Fade{Duration: ghostFadeTicks, From: GhostAlpha})
+			}
+			continue
+		}
+
+		frame := ghost.Frames[ghost.Tick]
+		ghost.Tick++
+		world.SetComponent(e, *ghost)
+
+		world.SetComponent(e, components.Position{
+			Vector: linalg.Vector2{X: frame.X, Y: frame.Y},
+		})
+
+		animator, err := ecs.GetComponent[components.CharacterAnimator](world, e)
+		if err != nil {
+			continue
+		}
+		animator.State = frame.State
+		animator.StateTicks = frame.StateTicks
+		animator.FacingLeft = frame.FacingLeft
+		world.SetComponent(e, *animator)
+
+		sprite, err := ecs.GetComponent[components.Sprite](world, e)
+		if err != nil {
+			continue
+		}
+		if img := animator.CurrentFrame(); img != nil {
+			sprite.Image = img
+		}
+		sprite.FlipX = frame.FacingLeft
+		world.SetComponent(e, *sprite)
+	}
+}
@@ -0,0 +1,295 @@
+package systems
+
+import (
+	"reflect"
+
+	"github.com/solarlune/resolv"
+
+	"github.com/game-jam-2026/dead-jump/internal/ecs"
+	"github.com/game-jam-2026/dead-jump/internal/ecs/components"
+	"github.com/game-jam-2026/dead-jump/pkg/linalg"
+)
+
+const (
+	corpseDissolveTicks = 40
+	flatCorpseScale     = 0.35
+)
+
+// ApplyHazards kills characters touching an armed hazard, honoring the
+// hazard's side and corpse outcome, and dissolves corpses in lava. Returns
+// true if the character died.
+func ApplyHazards(world *ecs.World, collisions []CollisionResult) bool {
+	dissolveCorpsesInHazards(world)
+
+	for _, col := range collisions {
+		charID, hazardID := col.EntityA, col.EntityB
+		// normal points from B to A, so down means A is underneath
+		below := col.Normal.Y > 0.5
+		if !isMortal(world, charID) {
+			charID, hazardID = col.EntityB, col.EntityA
+			below = col.Normal.Y < -0.5
+		}
+
+		if !isMortal(world, charID) {
+			continue
+		}
+
+		hazard, err := ecs.GetComponent[components.Hazard](world, hazardID)
+		if err != nil || hazard.Disarmed {
+			continue
+		}
+
+		if hazard.Side == components.HazardSideBelow && !below {
+			continue
+		}
+
+		if isInvulnerable(world, charID) {
+			continue
+		}
+
+		if KillCharacterWith(world, charID, Death{Cause: hazard.Cause, Source: hazardID}) {
+			return true
+		}
+	}
+	return false
+}
+
+func dissolveCorpsesInHazards(world *ecs.World) {
+	hazards := world.GetEntities(
+		reflect.TypeOf((*components.Hazard)(nil)).Elem(),
+		reflect.TypeOf((*components.Collision)(nil)).Elem(),
+	)
+
+	corpses := world.GetEntities(
+		reflect.TypeOf((*components.Corpse)(nil)).Elem(),
+		reflect.TypeOf((*components.Collision)(nil)).Elem(),
+	)
+
+	for _, h := range hazards {
+		hazard, err := ecs.GetComponent[components.Hazard](world, h)
+		if err != nil || !hazard.DissolveCorpses {
+			continue
+		}
+
+		hazardCol, err := ecs.GetComponent[components.Collision](world, h)
+		if err != nil {
+			continue
+		}
+		// corpses resting on top only touch the surface
+		area := hazardCol.Shape.Bounds()
+		area.Min.Y -= 1
+
+		for _, c := range corpses {
+			corpseCol, err := ecs.GetComponent[components.Collision](world, c)
+			if err != nil {
+				continue
+			}
+			if corpseCol.Shape.Bounds().IsIntersecting(area) {
+				dissolveCorpse(world, c)
+			}
+		}
+	}
+}
+
+// dissolveCorpse stops the corpse from being a platform and fades it out.
+func dissolveCorpse(world *ecs.World, e ecs.EntityID) {
+	_ = world.RemoveComponent(e, components.Corpse{})
+	_ = world.RemoveComponent(e, components.Collision{})
+	_ = world.RemoveComponent(e, components.PhysicsBody{})
+	_ = world.RemoveComponent(e, components.Velocity{})
+	world.SetComponent(e, components.Fade{Duration: corpseDissolveTicks})
+}
+
+// flattenCorpse squashes the corpse to a thin slab, keeping its bottom edge.
+func flattenCorpse(world *ecs.World, e ecs.EntityID) {
+	sprite, err := ecs.GetComponent[components.Sprite](world, e)
+	if err != nil {
+		return
+	}
+	pos, err := ecs.GetComponent[components.Position](world, e)
+	if err != nil {
+		return
+	}
+
+	width, height := sprite.Size()
+	scale := sprite.EffectiveScale()
+	sprite.Scale = linalg.Vector2{X: scale.X * 1.2, Y: scale.Y * flatCorpseScale}
+	flatWidth, flatHeight := sprite.Size()
+
+	pos.Vector.X -= (flatWidth - width) / 2
+	pos.Vector.Y += height - flatHeight
+
+	world.SetComponent(e, *sprite)
+	world.SetComponent(e, *pos)
+	world.SetComponent(e, components.Collision{
+		Shape: resolv.NewRectangleFromTopLeft(pos.Vector.X, pos.Vector.Y, flatWidth, flatHeight),
+	})
+}
+
+// UpdateSaws spins saw blades and moves the ones with a Patrol along it.
+func UpdateSaws(world *ecs.World) {
+	entities := world.GetEntities(
+		reflect.TypeOf((*components.Saw)(nil)).Elem(),
+		reflect.TypeOf((*components.Position)(nil)).Elem(),
+	)
+
+	for _, e := range entities {
+		saw, err := ecs.GetComponent[components.Saw](world, e)
+		if err != nil {
+			continue
+		}
+
+		if sprite, err := ecs.GetComponent[components.Sprite](world, e); err == nil {
+			sprite.Rotation += saw.Spin
+			world.SetComponent(e, *sprite)
+		}
+
+		followPatrol(world, e)
+	}
+}
+
+// UpdateFallingBlocks shakes a block once the character is underneath, drops
+// it and turns it into a static platform after it lands.
+func UpdateFallingBlocks(world *ecs.World) {
+	entities := world.GetEntities(
+		reflect.TypeOf((*components.FallingBlock)(nil)).Elem(),
+		reflect.TypeOf((*components.Position)(nil)).Elem(),
+	)
+
+	for _, e := range entities {
+		block, err := ecs.GetComponent[components.FallingBlock](world, e)
+		if err != nil {
+			continue
+		}
+
+		pos, err := ecs.GetComponent[components.Position](world, e)
+		if err != nil {
+			continue
+		}
+
+		block.Ticks++
+
+		switch block.State {
+		case components.FallingIdle:
+			if characterBelow(world, e, block.Reach) {
+				block.State = components.FallingShaking
+				block.Ticks = 0
+			}
+
+		case components.FallingShaking:
+			pos.Vector.X = block.Origin.X
+			if block.Ticks%4 < 2 {
+				pos.Vector.X++
+			}
+
+			if block.Ticks >= block.ShakeTicks {
+				pos.Vector.X = block.Origin.X
+				block.State = components.FallingDropping
+				block.Ticks = 0
+
+				body := components.DefaultPhysicsBody()
+				body.Mass = 20
+				body.Friction = 0.8
+				body.AirDrag = 0
+				body.MaxSpeed = 12
+				world.SetComponent(e, body)
+				world.SetComponent(e, components.Velocity{Vector: linalg.Zero()})
+				setHazardArmed(world, e, true)
+			}
+			world.SetComponent(e, *pos)
+
+		case components.FallingDropping:
+			body, err := ecs.GetComponent[components.PhysicsBody](world, e)
+			if err == nil && body.IsGrounded && block.Ticks > 2 {
+				block.State = components.FallingSettled
+				_ = world.RemoveComponent(e, components.Velocity{})
+				world.SetComponent(e, components.StaticBody())
+				setHazardArmed(world, e, false)
+			}
+		}
+
+		world.SetComponent(e, *block)
+	}
+}
+
+// UpdateCrushers moves crushers through their slam cycle. They are only
+// dangerous on the way down.
+func UpdateCrushers(world *ecs.World) {
+	entities := world.GetEntities(
+		reflect.TypeOf((*components.Crusher)(nil)).Elem(),
+		reflect.TypeOf((*components.Position)(nil)).Elem(),
+	)
+
+	for _, e := range entities {
+		crusher, err := ecs.GetComponent[components.Crusher](world, e)
+		if err != nil {
+			continue
+		}
+
+		pos, err := ecs.GetComponent[components.Position](world, e)
+		if err != nil {
+			continue
+		}
+
+		crusher.Ticks++
+
+		switch crusher.State {
+		case components.CrusherWaitTop:
+			if crusher.Ticks >= crusher.WaitTicks {
+				crusher.State = components.CrusherFalling
+				crusher.Ticks = 0
+				setHazardArmed(world, e, true)
+			}
+		case components.CrusherFalling:
+			pos.Vector.Y += crusher.DownSpeed
+			if pos.Vector.Y >= crusher.Bottom {
+				pos.Vector.Y = crusher.Bottom
+				crusher.State = components.CrusherWaitBottom
+				crusher.Ticks = 0
+				setHazardArmed(world, e, false)
+			}
+		case components.CrusherWaitBottom:
+			if crusher.Ticks >= crusher.WaitTicks {
+				crusher.State = components.CrusherRising
+				crusher.Ticks = 0
+			}
+		case components.CrusherRising:
+			pos.Vector.Y -= crusher.UpSpeed
+			if pos.Vector.Y <= crusher.Top {
+				pos.Vector.Y = crusher.Top
+				crusher.State = components.CrusherWaitTop
+				crusher.Ticks = 0
+			}
+		}
+
+		world.SetComponent(e, *crusher)
+		world.SetComponent(e, *pos)
+	}
+}
+
+func setHazardArmed(world *ecs.World, e ecs.EntityID, armed bool) {
+	hazard, err := ecs.GetComponent[components.Hazard](world, e)
+	if err != nil {
+		return
+	}
+	hazard.Disarmed = !armed
+	world.SetComponent(e, *hazard)
+}
+
+// characterBelow reports a character horizontally inside the entity bounds
+// and at most reach pixels under it.
+func characterBelow(world *ecs.World, e ecs.EntityID, reach float64) bool {
+	col, err := ecs.GetComponent[components.Collision](world, e)
+	if err != nil {
+		return false
+	}
+	bounds := col.Shape.Bounds()
+
+	target, ok := findCannonTarget(world)
+	if !ok {
+		return false
+	}
+
+	return target.X >= bounds.Min.X && target.X <= bounds.Max.X &&
+		target.Y > bounds.Max.Y && target.Y-bounds.Max.Y <= reach
+}
@@ -0,0 +1,79 @@
+package systems
+
+import (
+	"reflect"
+
+	"github.com/game-jam-2026/dead-jump/internal/ecs"
+	"github.com/game-jam-2026/dead-jump/internal/ecs/components"
+)
+
+// ApplyLevelBounds keeps hero bodies inside the level's side edges and kills
+// the ones that fell below the kill plane. Corpses falling out are removed.
+// Returns true if the character died.
+func ApplyLevelBounds(world *ecs.World) bool {
+	bounds, err := ecs.GetResource[components.LevelBounds](world)
+	if err != nil {
+		return false
+	}
+
+	entities := world.GetEntities(
+		reflect.TypeOf((*components.Position)(nil)).Elem(),
+		reflect.TypeOf((*components.Collision)(nil)).Elem(),
+	)
+
+	died := false
+	for _, e := range entities {
+		col, err := ecs.GetComponent[components.Collision](world, e)
+		if err != nil {
+			continue
+		}
+		box := col.Shape.Bounds()
+
+		if _, err := ecs.GetComponent[components.Corpse](world, e); err == nil {
+			if box.Min.Y > bounds.KillY {
+				world.DestroyEntity(e)
+			}
+			continue
+		}
+
+		if !isMortal(world, e) {
+			continue
+		}
+
+		if box.Min.Y > bounds.KillY {
+			if KillCharacterWith(world, e, Death{Cause: components.DeathFall}) {
+				died = true
+			}
+			continue
+		}
+
+		keepInside(world, e, bounds, box.Min.X, box.Width())
+	}
+	return died
+}
+
+// keepInside pushes a body that crossed a side edge back in and stops its
+// horizontal motion.
+func keepInside(world *ecs.World, e ecs.EntityID, bounds *components.LevelBounds, x, width float64) {
+	dx := 0.0
+	if x < bounds.Left {
+		dx = bounds.Left - x
+	} else if x+width > bounds.Right {
+		dx = bounds.Right - (x + width)
+	}
+	if dx == 0 {
+		return
+	}
+
+	pos, err := ecs.GetComponent[components.Position](world, e)
+	if err != nil {
+		return
+	}
+	pos.Vector.X += dx
+	world.SetComponent(e, *pos)
+
+	if vel, err := ecs.GetComponent[components.Velocity](world, e); err == nil {
+		vel.Vector.X = 0
+		world.SetComponent(e, *vel)
+	}
+}
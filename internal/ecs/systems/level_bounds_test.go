@@ -0,0 +1,109 @@
+package systems
+
+import (
+	"testing"
+
+	"github.com/game-jam-2026/dead-jump/internal/ecs"
+	"github.com/game-jam-2026/dead-jump/internal/ecs/components"
+	"github.com/game-jam-2026/dead-jump/pkg/linalg"
+)
+
+// moveBoxTo moves e so that the top left of its collision box is at (x, y)
+// and returns the box width.
+func moveBoxTo(t *testing.T, w *ecs.World, e ecs.EntityID, x, y float64) float64 {
+	t.Helper()
+	col, err := ecs.GetComponent[components.Collision](w, e)
+	if err != nil {
+		t.Fatal(err)
+	}
+	pos, err := ecs.GetComponent[components.Position](w, e)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	box := col.Shape.Bounds()
+	delta := linalg.Vector2{X: x - box.Min.X, Y: y - box.Min.Y}
+	col.Shape.Move(delta.X, delta.Y)
+	pos.Vector = pos.Vector.Add(delta)
+	w.SetComponent(e, *col)
+	w.SetComponent(e, *pos)
+	return box.Width()
+}
+
+func TestApplyLevelBoundsKillPlane(t *testing.T) {
+	bounds := components.NewLevelBounds(320, 240)
+
+	tests := []struct {
+		name string
+		y    float64
+		dies bool
+	}{
+		{"on screen", 200, false},
+		{"below the screen, above the plane", bounds.KillY - 10, false},
+		{"on the plane", bounds.KillY, false},
+		{"past the plane", bounds.KillY + 1, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			w, hero := heroWorld(t, false)
+			w.SetResource(bounds)
+			moveBoxTo(t, w, hero, 100, tt.y)
+
+			if got := ApplyLevelBounds(w); got != tt.dies {
+				t.Fatalf("ApplyLevelBounds() = %v, want %v", got, tt.dies)
+			}
+			if tt.dies && livesLeft(t, w) != testLives-1 {
+				t.Error("fall death didn't cost a life")
+			}
+		})
+	}
+}
+
+func TestApplyLevelBoundsSides(t *testing.T) {
+	tests := []struct {
+		name string
+		x    func(width float64) float64
+		push func(width float64) float64
+	}{
+		{"inside", func(float64) float64 { return 100 }, func(float64) float64 { return 0 }},
+		{"past the left edge", func(float64) float64 { return -5 }, func(float64) float64 { return 5 }},
+		{"past the right edge", func(w float64) float64 { return 325 - w }, func(float64) float64 { return -5 }},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			w, hero := heroWorld(t, true)
+			w.SetResource(components.NewLevelBounds(320, 240))
+			width := moveBoxTo(t, w, hero, 0, 100)
+			moveBoxTo(t, w, hero, tt.x(width), 100)
+			before, _ := ecs.GetComponent[components.Position](w, hero)
+
+			ApplyLevelBounds(w)
+
+			pos, _ := ecs.GetComponent[components.Position](w, hero)
+			if got, want := pos.Vector.X-before.Vector.X, tt.push(width); got != want {
+				t.Errorf("pushed by %v, want %v", got, want)
+			}
+			vel, _ := ecs.GetComponent[components.Velocity](w, hero)
+			if stopped := vel.Vector.X == 0; stopped != (tt.push(width) != 0) {
+				t.Errorf("horizontal velocity = %v after the push", vel.Vector.X)
+			}
+		})
+	}
+}
+
+func TestApplyLevelBoundsRemovesFallenCorpses(t *testing.T) {
+	w, hero := heroWorld(t, true)
+	bounds := components.NewLevelBounds(320, 240)
+	w.SetResource(bounds)
+	KillCharacter(w, hero)
+
+	moveBoxTo(t, w, hero, 100, bounds.KillY+1)
+	if ApplyLevelBounds(w) {
+		t.Error("a fallen corpse killed the character")
+	}
+	if hasComponent[components.Corpse](w, hero) {
+		t.Error("corpse below the kill plane wasn't removed")
+	}
+}
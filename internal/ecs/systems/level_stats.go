@@ -0,0 +1,29 @@
+package systems
+
+import (
+	"github.com/game-jam-2026/dead-jump/internal/ecs"
+	"github.com/game-jam-2026/dead-jump/internal/ecs/components"
+)
+
+// UpdateLevelStats advances the level clock.
+func UpdateLevelStats(world *ecs.World) {
+	stats := levelStats(world)
+	stats.Ticks++
+	world.SetResource(stats)
+}
+
+func recordDeath(world *ecs.World) {
+	stats := levelStats(world)
+	stats.Deaths++
+	world.SetResource(stats)
+}
+
+// levelStats returns the stats resource, starting from zero in worlds that
+// don't have one yet.
+func levelStats(world *ecs.World) components.LevelStats {
+	stats, err := ecs.GetResource[components.LevelStats](world)
+	if err != nil {
+		return components.LevelStats{}
+	}
+	return *stats
+}
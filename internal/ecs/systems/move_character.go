@@ -3,9 +3,6 @@ package systems
 import (
 	"reflect"
 
-	"github.com/hajimehoshi/ebiten/v2"
-	"github.com/hajimehoshi/ebiten/v2/inpututil"
-
 	"github.com/game-jam-2026/dead-jump/internal/ecs"
 	"github.com/game-jam-2026/dead-jump/internal/ecs/components"
 	"github.com/game-jam-2026/dead-jump/internal/utils/audio"
@@ -16,6 +13,8 @@ const (
 	MoveSpeed         = 0.5
 	JumpForce         = 6.0
 	StepSoundCooldown = 10
+	// AirControlAssist multiplies steering in the air with the assist on
+	AirControlAssist = 1.6
 )
 
 var stepSoundTimer int
@@ -31,41 +30,55 @@ func MoveCharacter(w *ecs.World) {
 		return
 	}
 
-	characterID := entities[0]
+	var in components.Input
+	if res, err := ecs.GetResource[components.Input](w); err == nil {
+		in = *res
+	}
 
-	body, err := ecs.GetComponent[components.PhysicsBody](w, characterID)
+	if stepSoundTimer > 0 {
+		stepSoundTimer--
+	}
+	if drive(w, entities[0], in) && stepSoundTimer == 0 {
+		audio.Play(audio.SoundStep)
+		stepSoundTimer = StepSoundCooldown
+	}
+}
+
+// drive applies one tick of input to a hero body. Returns true if it walks
+// on the ground.
+func drive(w *ecs.World, e ecs.EntityID, in components.Input) bool {
+	body, err := ecs.GetComponent[components.PhysicsBody](w, e)
 	if err != nil {
-		return
+		return false
 	}
 
-	vel, err := ecs.GetComponent[components.Velocity](w, characterID)
+	vel, err := ecs.GetComponent[components.Velocity](w, e)
 	if err != nil {
-		return
+		return false
 	}
 
-	isMovingLeft := ebiten.IsKeyPressed(ebiten.KeyLeft) || ebiten.IsKeyPressed(ebiten.KeyA)
-	isMovingRight := ebiten.IsKeyPressed(ebiten.KeyRight) || ebiten.IsKeyPressed(ebiten.KeyD)
+	isMovingLeft := in.IsHeld(components.ButtonLeft)
+	isMovingRight := in.IsHeld(components.ButtonRight)
 
-	if isMovingLeft {
-		body.AddForce(linalg.Vector2{X: -MoveSpeed * body.Mass, Y: 0})
-	}
-	if isMovingRight {
-		body.AddForce(linalg.Vector2{X: MoveSpeed * body.Mass, Y: 0})
+	speed := MoveSpeed
+	if assist, err := ecs.GetResource[components.Assist](w); err == nil && assist.AirControl && !body.IsGrounded {
+		speed *= AirControlAssist
 	}
 
-	if stepSoundTimer > 0 {
-		stepSoundTimer--
+	if isMovingLeft {
+		body.AddForce(linalg.Vector2{X: -speed * body.Mass, Y: 0})
 	}
-	if body.IsGrounded && (isMovingLeft || isMovingRight) && stepSoundTimer == 0 {
-		audio.Play(audio.SoundStep)
-		stepSoundTimer = StepSoundCooldown
+	if isMovingRight {
+		body.AddForce(linalg.Vector2{X: speed * body.Mass, Y: 0})
 	}
+	walking := body.IsGrounded && (isMovingLeft || isMovingRight)
 
-	if body.IsGrounded && (inpututil.IsKeyJustPressed(ebiten.KeySpace) || inpututil.IsKeyJustPressed(ebiten.KeyW) || inpututil.IsKeyJustPressed(ebiten.KeyUp)) {
+	if body.IsGrounded && in.JustPressed(components.ButtonJump) {
 		vel.Vector.Y = -JumpForce
 		body.IsGrounded = false
-		w.SetComponent(characterID, *vel)
+		w.SetComponent(e, *vel)
 	}
 
-	w.SetComponent(characterID, *body)
+	w.SetComponent(e, *body)
+	return walking
 }
@@ -0,0 +1,302 @@
+package systems
+
+import (
+	"image/color"
+	"math"
+	"reflect"
+
+	"github.com/hajimehoshi/ebiten/v2"
+
+	"github.com/game-jam-2026/dead-jump/internal/ecs"
+	"github.com/game-jam-2026/dead-jump/internal/ecs/components"
+	"github.com/game-jam-2026/dead-jump/pkg/linalg"
+)
+
+const explosionFadeTicks = 18
+
+// explodeProjectile pushes the player and corpses away from the projectile,
+// strongest at the center and fading out to ExplosionRadius.
+func explodeProjectile(world *ecs.World, projectile ecs.EntityID, t *ProjectileType) {
+	center, ok := entityCenter(world, projectile)
+	world.DestroyEntity(projectile)
+	if !ok {
+		return
+	}
+
+	spawnExplosion(world, center, t.ExplosionRadius)
+
+	characters := world.GetEntities(
+		reflect.TypeOf((*components.PhysicsBody)(nil)).Elem(),
+	)
+	for _, e := range characters {
+		if !isMortal(world, e) {
+			continue
+		}
+		impulse, ok := explosionImpulse(world, e, center, t)
+		if !ok {
+			continue
+		}
+
+		body, err := ecs.GetComponent[components.PhysicsBody](world, e)
+		if err != nil {
+			continue
+		}
+		vel, err := ecs.GetComponent[components.Velocity](world, e)
+		if err != nil {
+			vel = &components.Velocity{}
+		}
+
+		vel.Vector = vel.Vector.Add(impulse)
+		body.IsGrounded = false
+		world.SetComponent(e, *vel)
+		world.SetComponent(e, *body)
+	}
+
+	corpses := world.GetEntities(
+		reflect.TypeOf((*components.Corpse)(nil)).Elem(),
+		reflect.TypeOf((*components.Collision)(nil)).Elem(),
+	)
+	for _, e := range corpses {
+		impulse, ok := explosionImpulse(world, e, center, t)
+		if ok {
+			launchCorpse(world, e, impulse)
+		}
+	}
+}
+
+func explosionImpulse(world *ecs.World, e ecs.EntityID, center linalg.Vector2, t *ProjectileType) (linalg.Vector2, bool) {
+	target, ok := entityCenter(world, e)
+	if !ok {
+		return linalg.Zero(), false
+	}
+
+	offset := target.Sub(center)
+	dist := offset.Length()
+	if dist > t.ExplosionRadius {
+		return linalg.Zero(), false
+	}
+
+	dir := offset.Normalized()
+	if dir.IsZero() {
+		dir = linalg.Up()
+	}
+	// чуть подбрасываем вверх, иначе взрыв под ногами просто прижимает к земле
+	dir = dir.Add(linalg.Up().Scale(0.5)).Normalized()
+
+	return dir.Scale(t.ExplosionImpulse * (1 - dist/t.ExplosionRadius)), true
+}
+
+// launchCorpse turns a static corpse into a dynamic body; SettleCorpses turns
+// it back once it comes to rest.
+func launchCorpse(world *ecs.World, e ecs.EntityID, impulse linalg.Vector2) {
+	corpse, err := ecs.GetComponent[components.Corpse](world, e)
+	if err != nil {
+		return
+	}
+
+	vel, err := ecs.GetComponent[components.Velocity](world, e)
+	if err != nil {
+		vel = &components.Velocity{}
+	}
+	vel.Vector = vel.Vector.Add(impulse)
+
+	corpse.IsSettled = false
+	corpse.RestTicks = 0
+	corpse.FlashTicks = components.CorpseFlashTicks
+
+	body := components.DefaultPhysicsBody()
+	body.Mass = 2
+	body.Friction = 0.6
+
+	world.SetComponent(e, *corpse)
+	world.SetComponent(e, *vel)
+	world.SetComponent(e, body)
+}
+
+// SettleCorpses freezes launched corpses that rested on the ground for
+// CorpseSettleTicks so they work as platforms again.
+func SettleCorpses(world *ecs.World) {
+	entities := world.GetEntities(
+		reflect.TypeOf((*components.Corpse)(nil)).Elem(),
+		reflect.TypeOf((*components.Velocity)(nil)).Elem(),
+		reflect.TypeOf((*components.PhysicsBody)(nil)).Elem(),
+	)
+
+	for _, e := range entities {
+		corpse, err := ecs.GetComponent[components.Corpse](world, e)
+		if err != nil || corpse.IsSettled {
+			continue
+		}
+
+		vel, err := ecs.GetComponent[components.Velocity](world, e)
+		if err != nil {
+			continue
+		}
+
+		body, err := ecs.GetComponent[components.PhysicsBody](world, e)
+		if err != nil {
+			continue
+		}
+
+		if body.IsGrounded && vel.Vector.Length() < 0.3 {
+			corpse.RestTicks++
+		} else {
+			corpse.RestTicks = 0
+		}
+
+		if corpse.RestTicks >= components.CorpseSettleTicks {
+			corpse.IsSettled = true
+			corpse.RestTicks = 0
+			_ = world.RemoveComponent(e, components.Velocity{})
+			world.SetComponent(e, components.StaticBody())
+		}
+
+		world.SetComponent(e, *corpse)
+	}
+}
+
+func stickProjectile(world *ecs.World, projectile ecs.EntityID, proj *components.Projectile, t *ProjectileType) {
+	proj.IsStationary = true
+	proj.Lifetime = t.StickLifetime
+	world.SetComponent(projectile, *proj)
+
+	if body, err := ecs.GetComponent[components.PhysicsBody](world, projectile); err == nil {
+		body.GravityScale = 0
+		body.IsKinematic = true
+		world.SetComponent(projectile, *body)
+	}
+
+	world.SetComponent(projectile, components.Velocity{Vector: linalg.Zero()})
+}
+
+// SteerHomingProjectiles turns homing projectiles towards the player by at
+// most HomingTurnRate per tick, keeping their speed.
+func SteerHomingProjectiles(world *ecs.World) {
+	target, ok := findCannonTarget(world)
+	if !ok {
+		return
+	}
+
+	entities := world.GetEntities(
+		reflect.TypeOf((*components.Projectile)(nil)).Elem(),
+		reflect.TypeOf((*components.Velocity)(nil)).Elem(),
+	)
+
+	for _, e := range entities {
+		proj, err := ecs.GetComponent[components.Projectile](world, e)
+		if err != nil || proj.IsStationary {
+			continue
+		}
+
+		t := GetProjectileType(proj.Type)
+		if t.HomingTurnRate <= 0 {
+			continue
+		}
+
+		center, ok := entityCenter(world, e)
+		if !ok {
+			continue
+		}
+
+		vel, err := ecs.GetComponent[components.Velocity](world, e)
+		if err != nil {
+			continue
+		}
+
+		speed := vel.Vector.Length()
+		heading := vel.Vector.Angle()
+		delta := math.Remainder(target.Sub(center).Angle()-heading, 2*math.Pi)
+		delta = clamp(delta, -t.HomingTurnRate, t.HomingTurnRate)
+
+		vel.Vector = linalg.FromAngle(heading + delta).Scale(speed)
+		world.SetComponent(e, *vel)
+	}
+}
+
+// UpdateFades fades sprites out and destroys the entity when done.
+func UpdateFades(world *ecs.World) {
+	entities := world.GetEntities(
+		reflect.TypeOf((*components.Fade)(nil)).Elem(),
+	)
+
+	for _, e := range entities {
+		fade, err := ecs.GetComponent[components.Fade](world, e)
+		if err != nil {
+			continue
+		}
+
+		fade.Ticks++
+		if fade.Ticks >= fade.Duration {
+			world.DestroyEntity(e)
+			continue
+		}
+		world.SetComponent(e, *fade)
+
+		sprite, err := ecs.GetComponent[components.Sprite](world, e)
+		if err != nil {
+			continue
+		}
+		from := fade.From
+		if from == 0 {
+			from = 1
+		}
+		alpha := from * (1 - float32(fade.Ticks)/float32(fade.Duration))
+		sprite.ColorScale = ebiten.ColorScale{}
+		sprite.ColorScale.ScaleAlpha(alpha)
+		world.SetComponent(e, *sprite)
+	}
+}
+
+func spawnExplosion(world *ecs.World, center linalg.Vector2, radius float64) {
+	e := world.CreateEntity()
+
+	img := explosionImage()
+	sprite := components.Sprite{Image: img, ZIndex: 20}
+	sprite.CenterOrigin()
+	sprite.SetUniformScale(2 * radius / float64(img.Bounds().Dx()))
+
+	world.SetComponent(e, components.Position{Vector: center})
+	world.SetComponent(e, sprite)
+	world.SetComponent(e, components.Fade{Duration: explosionFadeTicks})
+}
+
+func entityCenter(world *ecs.World, e ecs.EntityID) (linalg.Vector2, bool) {
+	pos, err := ecs.GetComponent[components.Position](world, e)
+	if err != nil {
+		return linalg.Zero(), false
+	}
+
+	center := pos.Vector
+	if col, err := ecs.GetComponent[components.Collision](world, e); err == nil {
+		bounds := col.Shape.Bounds()
+		center = center.Add(linalg.Vector2{X: bounds.Width() / 2, Y: bounds.Height() / 2})
+	}
+	return center, true
+}
+
+var blastImage *ebiten.Image
+
+func explosionImage() *ebiten.Image {
+	if blastImage != nil {
+		return blastImage
+	}
+
+	size := 32
+	r := float64(size) / 2
+	img := ebiten.NewImage(size, size)
+	for i := 0; i < size; i++ {
+		for j := 0; j < size; j++ {
+			dx := float64(i) + 0.5 - r
+			dy := float64(j) + 0.5 - r
+			d := math.Sqrt(dx*dx+dy*dy) / r
+			if d >= 1 {
+				continue
+			}
+			// горячий центр, красный край
+			img.Set(i, j, color.RGBA{255, uint8(230 - 170*d), uint8(120 * (1 - d)), uint8(220 - 120*d)})
+		}
+	}
+
+	blastImage = img
+	return blastImage
+}
@@ -0,0 +1,154 @@
+package systems
+
+import (
+	"image/color"
+	"sort"
+
+	"github.com/hajimehoshi/ebiten/v2"
+)
+
+type ProjectileHit int
+
+const (
+	// HitDestroy removes the projectile on impact.
+	HitDestroy ProjectileHit = iota
+	// HitBounce keeps bouncing until MaxBounces static hits.
+	HitBounce
+	// HitExplode pushes the player and corpses within ExplosionRadius away.
+	HitExplode
+	// HitStick freezes the projectile on a static surface, where it serves as
+	// a platform for StickLifetime ticks.
+	HitStick
+)
+
+const (
+	ProjectileShell     = "shell"
+	ProjectileBouncing  = "bouncing"
+	ProjectileExplosive = "explosive"
+	ProjectileHoming    = "homing"
+	ProjectileSticky    = "sticky"
+)
+
+// ProjectileType describes a kind of projectile a cannon can fire. Zero Mass
+// means the firing cannon's ProjectileMass is used.
+type ProjectileType struct {
+	Name         string
+	Image        func() *ebiten.Image
+	Mass         float64
+	Bounciness   float64
+	GravityScale float64
+	Lifetime     int
+	OnHit        ProjectileHit
+
+	MaxBounces       int
+	ExplosionRadius  float64
+	ExplosionImpulse float64
+	// HomingTurnRate is the max steering per tick in radians, 0 disables homing
+	HomingTurnRate float64
+	StickLifetime  int
+	// Lethal projectiles kill the character they hit instead of pushing it
+	Lethal bool
+}
+
+var projectileTypes = make(map[string]*ProjectileType)
+
+func RegisterProjectileType(t ProjectileType) {
+	projectileTypes[t.Name] = &t
+}
+
+// GetProjectileType returns the named type; unknown names fall back to the
+// plain shell.
+func GetProjectileType(name string) *ProjectileType {
+	if t, ok := projectileTypes[name]; ok {
+		return t
+	}
+	return projectileTypes[ProjectileShell]
+}
+
+func ProjectileTypeNames() []string {
+	names := make([]string, 0, len(projectileTypes))
+	for name := range projectileTypes {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+func init() {
+	RegisterProjectileType(ProjectileType{
+		Name:         ProjectileShell,
+		Image:        projectileImage,
+		GravityScale: 1,
+		Lifetime:     300,
+		OnHit:        HitDestroy,
+	})
+	RegisterProjectileType(ProjectileType{
+		Name:         ProjectileBouncing,
+		Image:        cachedBall(ProjectileBouncing, 8, color.RGBA{60, 140, 70, 255}, color.RGBA{150, 220, 140, 255}),
+		Mass:         6,
+		Bounciness:   0.85,
+		GravityScale: 1,
+		Lifetime:     420,
+		OnHit:        HitBounce,
+		MaxBounces:   4,
+	})
+	RegisterProjectileType(ProjectileType{
+		Name:             ProjectileExplosive,
+		Image:            cachedBall(ProjectileExplosive, 10, color.RGBA{120, 30, 30, 255}, color.RGBA{255, 200, 60, 255}),
+		Mass:             10,
+		GravityScale:     1,
+		Lifetime:         300,
+		OnHit:            HitExplode,
+		ExplosionRadius:  48,
+		ExplosionImpulse: 18,
+	})
+	RegisterProjectileType(ProjectileType{
+		Name:           ProjectileHoming,
+		Image:          cachedBall(ProjectileHoming, 10, color.RGBA{110, 50, 170, 230}, color.RGBA{230, 190, 255, 255}),
+		Mass:           4,
+		GravityScale:   0,
+		Lifetime:       360,
+		OnHit:          HitDestroy,
+		HomingTurnRate: 0.04,
+		Lethal:         true,
+	})
+	RegisterProjectileType(ProjectileType{
+		Name:          ProjectileSticky,
+		Image:         cachedBall(ProjectileSticky, 10, color.RGBA{150, 170, 40, 255}, color.RGBA{220, 240, 120, 255}),
+		Mass:          5,
+		GravityScale:  1,
+		Lifetime:      300,
+		OnHit:         HitStick,
+		StickLifetime: 240,
+	})
+}
+
+var ballImages = make(map[string]*ebiten.Image)
+
+// cachedBall draws a round projectile with a highlight in front so the
+// rotation is visible.
+func cachedBall(name string, size int, body, highlight color.RGBA) func() *ebiten.Image {
+	return func() *ebiten.Image {
+		if img, ok := ballImages[name]; ok {
+			return img
+		}
+
+		img := ebiten.NewImage(size, size)
+		r := float64(size) / 2
+		for i := 0; i < size; i++ {
+			for j := 0; j < size; j++ {
+				dx := float64(i) + 0.5 - r
+				dy := float64(j) + 0.5 - r
+				if dx*dx+dy*dy < r*r {
+					img.Set(i, j, body)
+				}
+			}
+		}
+		img.Set(size-3, size/2-1, highlight)
+		img.Set(size-2, size/2-1, highlight)
+		img.Set(size-3, size/2, highlight)
+
+		ballImages[name] = img
+		return img
+	}
+}
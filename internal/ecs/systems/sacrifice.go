@@ -0,0 +1,44 @@
+package systems
+
+import (
+	"reflect"
+
+	"github.com/game-jam-2026/dead-jump/internal/ecs"
+	"github.com/game-jam-2026/dead-jump/internal/ecs/components"
+)
+
+// ApplySacrifice kills the character on the spot when the player asks for
+// it and the level allows it. It costs a life like any other death. Returns
+// true if the character died.
+func ApplySacrifice(world *ecs.World) bool {
+	in, err := ecs.GetResource[components.Input](world)
+	if err != nil || !in.JustPressed(components.ButtonSacrifice) {
+		return false
+	}
+
+	characters := world.GetEntities(
+		reflect.TypeOf((*components.Character)(nil)).Elem(),
+		reflect.TypeOf((*components.PhysicsBody)(nil)).Elem(),
+	)
+	if len(characters) == 0 {
+		return false
+	}
+
+	rule, err := ecs.GetResource[components.Sacrifices](world)
+	if err == nil {
+		if !rule.Allowed() {
+			return false
+		}
+		rule.Used++
+		world.SetResource(*rule)
+	}
+
+	dropLastInput(world)
+	sacrifice(world, characters[0])
+	return true
+}
+
+// sacrifice kills a hero body where it is.
+func sacrifice(world *ecs.World, e ecs.EntityID) {
+	KillCharacterWith(world, e, Death{Cause: components.DeathSacrifice})
+}
@@ -0,0 +1,430 @@
+package systems
+
+import (
+	"reflect"
+
+	"github.com/hajimehoshi/ebiten/v2"
+
+	"github.com/game-jam-2026/dead-jump/internal/ecs"
+	"github.com/game-jam-2026/dead-jump/internal/ecs/components"
+	"github.com/game-jam-2026/dead-jump/pkg/linalg"
+)
+
+const plateSink = 2
+
+// UpdateSignals reads plates, levers and buttons, settles the logic gates and
+// updates every SignalReceiver, then applies the result to cannons and
+// spikes. Doors and moving platforms read their receivers on their own.
+func UpdateSignals(world *ecs.World) {
+	signals := make(map[string]bool)
+
+	updatePressurePlates(world, signals)
+	updateLevers(world, signals)
+	updateTimedButtons(world, signals)
+	evaluateLogicGates(world, signals)
+
+	receivers := world.GetEntities(
+		reflect.TypeOf((*components.SignalReceiver)(nil)).Elem(),
+	)
+
+	for _, e := range receivers {
+		receiver, err := ecs.GetComponent[components.SignalReceiver](world, e)
+		if err != nil {
+			continue
+		}
+
+		receiver.Active = signals[receiver.Input] != receiver.Invert
+		world.SetComponent(e, *receiver)
+
+		if cannon, err := ecs.GetComponent[components.Cannon](world, e); err == nil {
+			cannon.Active = receiver.Active
+			world.SetComponent(e, *cannon)
+		}
+
+		if spike, err := ecs.GetComponent[components.Spike](world, e); err == nil && spike.Retracted != receiver.Active {
+			spike.Retracted = receiver.Active
+			world.SetComponent(e, *spike)
+			setSpriteAlpha(world, e, receiver.Active, 0.25)
+		}
+	}
+}
+
+func updatePressurePlates(world *ecs.World, signals map[string]bool) {
+	plates := world.GetEntities(
+		reflect.TypeOf((*components.PressurePlate)(nil)).Elem(),
+		reflect.TypeOf((*components.Collision)(nil)).Elem(),
+	)
+
+	for _, e := range plates {
+		plate, err := ecs.GetComponent[components.PressurePlate](world, e)
+		if err != nil {
+			continue
+		}
+
+		col, err := ecs.GetComponent[components.Collision](world, e)
+		if err != nil {
+			continue
+		}
+
+		pressed := plateHeldDown(world, e, col)
+		if pressed != plate.Pressed {
+			plate.Pressed = pressed
+			world.SetComponent(e, *plate)
+
+			if pos, err := ecs.GetComponent[components.Position](world, e); err == nil {
+				if pressed {
+					pos.Vector.Y += plateSink
+				} else {
+					pos.Vector.Y -= plateSink
+				}
+				world.SetComponent(e, *pos)
+			}
+		}
+
+		signals[plate.Output] = signals[plate.Output] || plate.Pressed
+	}
+}
+
+// plateHeldDown checks characters, corpses and settled projectiles touching
+// the plate; a pressed plate sinks, so anything resting on it still counts.
+func plateHeldDown(world *ecs.World, plate ecs.EntityID, col *components.Collision) bool {
+	area := col.Shape.Bounds()
+	area.Min.Y -= plateSink + 1
+
+	candidates := world.GetEntities(
+		reflect.TypeOf((*components.Collision)(nil)).Elem(),
+		reflect.TypeOf((*components.Position)(nil)).Elem(),
+	)
+
+	for _, e := range candidates {
+		if e == plate {
+			continue
+		}
+
+		if !isPlateWeight(world, e) {
+			continue
+		}
+
+		other, err := ecs.GetComponent[components.Collision](world, e)
+		if err != nil {
+			continue
+		}
+
+		if other.Shape.Bounds().IsIntersecting(area) {
+			return true
+		}
+	}
+	return false
+}
+
+func isPlateWeight(world *ecs.World, e ecs.EntityID) bool {
+	if isMortal(world, e) {
+		return true
+	}
+	if _, err := ecs.GetComponent[components.Corpse](world, e); err == nil {
+		return true
+	}
+	if proj, err := ecs.GetComponent[components.Projectile](world, e); err == nil {
+		return proj.IsStationary
+	}
+	return false
+}
+
+func updateLevers(world *ecs.World, signals map[string]bool) {
+	levers := world.GetEntities(
+		reflect.TypeOf((*components.Lever)(nil)).Elem(),
+		reflect.TypeOf((*components.Collision)(nil)).Elem(),
+	)
+
+	for _, e := range levers {
+		lever, err := ecs.GetComponent[components.Lever](world, e)
+		if err != nil {
+			continue
+		}
+
+		touching := characterTouches(world, e)
+		if touching && !lever.Touching {
+			lever.On = !lever.On
+			if sprite, err := ecs.GetComponent[components.Sprite](world, e); err == nil {
+				sprite.FlipX = lever.On
+				world.SetComponent(e, *sprite)
+			}
+		}
+		lever.Touching = touching
+		world.SetComponent(e, *lever)
+
+		signals[lever.Output] = signals[lever.Output] || lever.On
+	}
+}
+
+func updateTimedButtons(world *ecs.World, signals map[string]bool) {
+	buttons := world.GetEntities(
+		reflect.TypeOf((*components.TimedButton)(nil)).Elem(),
+		reflect.TypeOf((*components.Collision)(nil)).Elem(),
+	)
+
+	for _, e := range buttons {
+		button, err := ecs.GetComponent[components.TimedButton](world, e)
+		if err != nil {
+			continue
+		}
+
+		wasOn := button.Ticks > 0
+		if characterTouches(world, e) {
+			button.Ticks = button.Duration
+		} else if button.Ticks > 0 {
+			button.Ticks--
+		}
+		world.SetComponent(e, *button)
+
+		on := button.Ticks > 0
+		if on != wasOn {
+			setSpriteAlpha(world, e, !on, 0.5)
+		}
+
+		signals[button.Output] = signals[button.Output] || on
+	}
+}
+
+// evaluateLogicGates settles the gate network: gates can feed each other, so
+// it runs until nothing changes (or one pass per gate, for loops). Timers
+// update their countdown once the network has settled.
+func evaluateLogicGates(world *ecs.World, signals map[string]bool) {
+	entities := world.GetEntities(
+		reflect.TypeOf((*components.LogicGate)(nil)).Elem(),
+	)
+	if len(entities) == 0 {
+		return
+	}
+
+	gates := make([]*components.LogicGate, 0, len(entities))
+	for _, e := range entities {
+		if gate, err := ecs.GetComponent[components.LogicGate](world, e); err == nil {
+			gates = append(gates, gate)
+		}
+	}
+
+	// emitter channels stay as they are, gate outputs are recomputed
+	emitted := make(map[string]bool, len(signals))
+	for k, v := range signals {
+		emitted[k] = v
+	}
+
+	for pass := 0; pass <= len(gates); pass++ {
+		next := make(map[string]bool, len(emitted))
+		for k, v := range emitted {
+			next[k] = v
+		}
+		for _, gate := range gates {
+			next[gate.Output] = next[gate.Output] || gateOutput(gate, signals)
+		}
+
+		changed := len(next) != len(signals)
+		for k, v := range next {
+			if signals[k] != v {
+				changed = true
+			}
+			signals[k] = v
+		}
+		if !changed {
+			break
+		}
+	}
+
+	for i, e := range entities {
+		gate := gates[i]
+		if gate.Op != components.LogicTimer {
+			continue
+		}
+		if firstInput(gate, signals) {
+			gate.Ticks = gate.Duration
+		} else if gate.Ticks > 0 {
+			gate.Ticks--
+		}
+		world.SetComponent(e, *gate)
+	}
+}
+
+func gateOutput(gate *components.LogicGate, signals map[string]bool) bool {
+	switch gate.Op {
+	case components.LogicAnd:
+		for _, in := range gate.Inputs {
+			if !signals[in] {
+				return false
+			}
+		}
+		return len(gate.Inputs) > 0
+	case components.LogicOr:
+		for _, in := range gate.Inputs {
+			if signals[in] {
+				return true
+			}
+		}
+		return false
+	case components.LogicNot:
+		return !firstInput(gate, signals)
+	case components.LogicTimer:
+		return firstInput(gate, signals) || gate.Ticks > 0
+	}
+	return false
+}
+
+func firstInput(gate *components.LogicGate, signals map[string]bool) bool {
+	return len(gate.Inputs) > 0 && signals[gate.Inputs[0]]
+}
+
+// UpdateDoors slides doors towards open or closed.
+func UpdateDoors(world *ecs.World) {
+	doors := world.GetEntities(
+		reflect.TypeOf((*components.Door)(nil)).Elem(),
+		reflect.TypeOf((*components.Position)(nil)).Elem(),
+	)
+
+	for _, e := range doors {
+		door, err := ecs.GetComponent[components.Door](world, e)
+		if err != nil {
+			continue
+		}
+
+		open := false
+		if receiver, err := ecs.GetComponent[components.SignalReceiver](world, e); err == nil {
+			open = receiver.Active
+		}
+
+		target := 0.0
+		if open {
+			target = 1
+		}
+		if door.Progress == target {
+			continue
+		}
+
+		if door.Progress < target {
+			door.Progress = min(target, door.Progress+door.Speed)
+		} else {
+			door.Progress = max(target, door.Progress-door.Speed)
+		}
+
+		world.SetComponent(e, *door)
+		world.SetComponent(e, components.Position{
+			Vector: door.Closed.Add(door.OpenOffset.Scale(door.Progress)),
+		})
+	}
+}
+
+// UpdateMovingPlatforms moves platforms along their Patrol path and carries
+// bodies standing on top.
+func UpdateMovingPlatforms(world *ecs.World) {
+	platforms := world.GetEntities(
+		reflect.TypeOf((*components.MovingPlatform)(nil)).Elem(),
+		reflect.TypeOf((*components.Patrol)(nil)).Elem(),
+	)
+
+	for _, e := range platforms {
+		if receiver, err := ecs.GetComponent[components.SignalReceiver](world, e); err == nil && !receiver.Active {
+			continue
+		}
+
+		col, err := ecs.GetComponent[components.Collision](world, e)
+		if err != nil {
+			continue
+		}
+		top := col.Shape.Bounds()
+		top.Max.Y = top.Min.Y
+		top.Min.Y -= 2
+
+		delta := followPatrol(world, e)
+		if delta.IsZero() {
+			continue
+		}
+
+		riders := world.GetEntities(
+			reflect.TypeOf((*components.Velocity)(nil)).Elem(),
+			reflect.TypeOf((*components.Collision)(nil)).Elem(),
+			reflect.TypeOf((*components.Position)(nil)).Elem(),
+		)
+		for _, r := range riders {
+			riderCol, err := ecs.GetComponent[components.Collision](world, r)
+			if err != nil {
+				continue
+			}
+			b := riderCol.Shape.Bounds()
+			if b.Max.Y < top.Min.Y || b.Max.Y > top.Max.Y+1 || b.Max.X <= top.Min.X || b.Min.X >= top.Max.X {
+				continue
+			}
+
+			pos, err := ecs.GetComponent[components.Position](world, r)
+			if err != nil {
+				continue
+			}
+			pos.Vector = pos.Vector.Add(delta)
+			world.SetComponent(r, *pos)
+		}
+	}
+}
+
+// followPatrol moves a kinematic entity towards its next patrol point and
+// returns how far it moved.
+func followPatrol(world *ecs.World, e ecs.EntityID) linalg.Vector2 {
+	patrol, err := ecs.GetComponent[components.Patrol](world, e)
+	if err != nil || len(patrol.Points) == 0 {
+		return linalg.Zero()
+	}
+
+	pos, err := ecs.GetComponent[components.Position](world, e)
+	if err != nil {
+		return linalg.Zero()
+	}
+	center, ok := entityCenter(world, e)
+	if !ok {
+		return linalg.Zero()
+	}
+
+	patrol.Next %= len(patrol.Points)
+	delta := patrol.Points[patrol.Next].Sub(center)
+	if delta.Length() <= patrol.Speed {
+		patrol.Next = (patrol.Next + 1) % len(patrol.Points)
+	} else {
+		delta = delta.Normalized().Scale(patrol.Speed)
+	}
+
+	pos.Vector = pos.Vector.Add(delta)
+	world.SetComponent(e, *patrol)
+	world.SetComponent(e, *pos)
+
+	return delta
+}
+
+// characterTouches reports whether a character overlaps or stands on e.
+func characterTouches(world *ecs.World, e ecs.EntityID) bool {
+	col, err := ecs.GetComponent[components.Collision](world, e)
+	if err != nil {
+		return false
+	}
+	area := col.Shape.Bounds()
+	area.Min.Y--
+
+	characters := world.GetEntities(
+		reflect.TypeOf((*components.Character)(nil)).Elem(),
+		reflect.TypeOf((*components.Collision)(nil)).Elem(),
+	)
+	for _, c := range characters {
+		charCol, err := ecs.GetComponent[components.Collision](world, c)
+		if err == nil && charCol.Shape.Bounds().IsIntersecting(area) {
+			return true
+		}
+	}
+	return false
+}
+
+func setSpriteAlpha(world *ecs.World, e ecs.EntityID, faded bool, alpha float32) {
+	sprite, err := ecs.GetComponent[components.Sprite](world, e)
+	if err != nil {
+		return
+	}
+	sprite.ColorScale = ebiten.ColorScale{}
+	if faded {
+		sprite.ColorScale.ScaleAlpha(alpha)
+	}
+	world.SetComponent(e, *sprite)
+}
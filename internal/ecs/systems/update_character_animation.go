@@ -0,0 +1,141 @@
+package systems
+
+import (
+	"math"
+	"reflect"
+
+	"github.com/game-jam-2026/dead-jump/internal/ecs"
+	"github.com/game-jam-2026/dead-jump/internal/ecs/components"
+)
+
+const (
+	RunSpeedThreshold = 0.3
+	FacingThreshold   = 0.1
+)
+
+func UpdateCharacterAnimation(w *ecs.World) {
+	entities := w.GetEntities(
+		reflect.TypeOf((*components.CharacterAnimator)(nil)).Elem(),
+		reflect.TypeOf((*components.Sprite)(nil)).Elem(),
+	)
+
+	for _, e := range entities {
+		animator, err := ecs.GetComponent[components.CharacterAnimator](w, e)
+		if err != nil {
+			continue
+		}
+
+		sprite, err := ecs.GetComponent[components.Sprite](w, e)
+		if err != nil {
+			continue
+		}
+
+		if _, err := ecs.GetComponent[components.Corpse](w, e); err == nil {
+			animator.SetState(components.AnimDeath)
+		} else {
+			body, err := ecs.GetComponent[components.PhysicsBody](w, e)
+			if err != nil {
+				continue
+			}
+			vel, err := ecs.GetComponent[components.Velocity](w, e)
+			if err != nil {
+				continue
+			}
+
+			if vel.Vector.X < -FacingThreshold {
+				animator.FacingLeft = true
+			} else if vel.Vector.X > FacingThreshold {
+				animator.FacingLeft = false
+			}
+
+			animator.SetState(nextCharacterState(w, e, animator, body, vel))
+		}
+
+		w.SetComponent(e, *animator)
+
+		img := animator.CurrentFrame()
+		if img != nil && (sprite.Image != img || sprite.FlipX != animator.FacingLeft) {
+			sprite.Image = img
+			sprite.FlipX = animator.FacingLeft
+			w.SetComponent(e, *sprite)
+		}
+	}
+}
+
+func nextCharacterState(
+	w *ecs.World,
+	e ecs.EntityID,
+	animator *components.CharacterAnimator,
+	body *components.PhysicsBody,
+	vel *components.Velocity,
+) components.AnimationState {
+	if body.IsGrounded {
+		if animator.State.IsAirborne() {
+			return components.AnimLand
+		}
+		if animator.State == components.AnimLand && !animator.IsFinished() {
+			return components.AnimLand
+		}
+		if math.Abs(vel.Vector.X) > RunSpeedThreshold {
+			return components.AnimRun
+		}
+		return components.AnimIdle
+	}
+
+	if vel.Vector.Y < 0 {
+		return components.AnimJumpRise
+	}
+	if isTouchingWall(w, e, animator.FacingLeft) {
+		return components.AnimWallSlide
+	}
+	return components.AnimFall
+}
+
+func isTouchingWall(w *ecs.World, entity ecs.EntityID, left bool) bool {
+	col, err := ecs.GetComponent[components.Collision](w, entity)
+	if err != nil {
+		return false
+	}
+
+	pos, err := ecs.GetComponent[components.Position](w, entity)
+	if err != nil {
+		return false
+	}
+
+	dx := 1.0
+	if left {
+		dx = -1
+	}
+
+	bounds := col.Shape.Bounds()
+	probe := col.Shape.Clone()
+	probe.SetPosition(pos.Vector.X+bounds.Width()/2+dx, pos.Vector.Y+bounds.Height()/2)
+
+	others := w.GetEntities(
+		reflect.TypeOf((*components.Collision)(nil)).Elem(),
+		reflect.TypeOf((*components.PhysicsBody)(nil)).Elem(),
+	)
+
+	for _, other := range others {
+		if other == entity {
+			continue
+		}
+
+		body, err := ecs.GetComponent[components.PhysicsBody](w, other)
+		if err != nil || !body.IsStatic() {
+			continue
+		}
+
+		otherCol, err := ecs.GetComponent[components.Collision](w, other)
+		if err != nil {
+			continue
+		}
+
+		intersection := probe.Intersection(otherCol.Shape)
+		if !intersection.IsEmpty() && math.Abs(intersection.MTV.X) > math.Abs(intersection.MTV.Y) {
+			return true
+		}
+	}
+
+	return false
+}
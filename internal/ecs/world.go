@@ -3,6 +3,7 @@ package ecs
 import (
 	"fmt"
 	"reflect"
+	"slices"
 )
 
 type World struct {
@@ -65,6 +66,9 @@ func (w *World) GetEntities(types ...reflect.Type) []EntityID {
 			result = append(result, entityID)
 		}
 	}
+	// map order is random; sorted IDs keep the simulation deterministic, so
+	// recorded inputs replay the same way
+	slices.Sort(result)
 	return result
 }
 
@@ -74,6 +78,43 @@ func (w *World) DestroyEntity(entity EntityID) {
 	}
 }
 
+// Cloner is implemented by components and resources holding state that must
+// not be shared between a world and its clone.
+type Cloner interface {
+	CloneComponent() interface{}
+}
+
+// Clone copies the world. Components are stored by value, so only Cloner
+// implementations need a deep copy.
+func (w *World) Clone() *World {
+	clone := &World{
+		Entities:  make(map[reflect.Type]map[EntityID]interface{}, len(w.Entities)),
+		Resources: make(map[reflect.Type]interface{}, len(w.Resources)),
+		LastID:    w.LastID,
+	}
+
+	for t, entities := range w.Entities {
+		copied := make(map[EntityID]interface{}, len(entities))
+		for e, component := range entities {
+			copied[e] = cloneValue(component)
+		}
+		clone.Entities[t] = copied
+	}
+
+	for t, resource := range w.Resources {
+		clone.Resources[t] = cloneValue(resource)
+	}
+
+	return clone
+}
+
+func cloneValue(v interface{}) interface{} {
+	if c, ok := v.(Cloner); ok {
+		return c.CloneComponent()
+	}
+	return v
+}
+
 func (w *World) SetResource(resource interface{}) {
 	t := reflect.TypeOf(resource)
 	w.Resources[t] = resource
@@ -0,0 +1,121 @@
+package editor
+
+import (
+	"fmt"
+	"image/color"
+	"math"
+
+	"github.com/hajimehoshi/ebiten/v2"
+	"github.com/hajimehoshi/ebiten/v2/ebitenutil"
+	"github.com/hajimehoshi/ebiten/v2/vector"
+
+	"github.com/game-jam-2026/dead-jump/internal/assets"
+	"github.com/game-jam-2026/dead-jump/internal/ecs/systems"
+)
+
+const lineHeight = 14
+
+var (
+	gridColor   = color.RGBA{255, 255, 255, 24}
+	boundsColor = color.RGBA{0, 255, 0, 255}
+	selectColor = color.RGBA{255, 220, 0, 255}
+	startColor  = color.RGBA{0, 160, 255, 255}
+	panelColor  = color.RGBA{0, 0, 0, 180}
+	levelColor  = color.RGBA{255, 80, 80, 255}
+)
+
+func (e *Editor) Draw(screen *ebiten.Image) {
+	if e.world != nil {
+		systems.DrawSpritesWithCamera(e.world, screen, &e.camera)
+	}
+
+	e.drawGrid(screen)
+	e.drawPlacements(screen)
+	e.drawPanel(screen)
+}
+
+func (e *Editor) drawGrid(screen *ebiten.Image) {
+	size := float64(assets.TileSize)
+	offX := -math.Mod(e.camera.Position.X, size)
+	offY := -math.Mod(e.camera.Position.Y, size)
+	if offX > 0 {
+		offX -= size
+	}
+	if offY > 0 {
+		offY -= size
+	}
+
+	for x := offX; x < viewportWidth; x += size {
+		vector.StrokeLine(screen, float32(x), 0, float32(x), viewportHeight, 1, gridColor, false)
+	}
+	for y := offY; y < viewportHeight; y += size {
+		vector.StrokeLine(screen, 0, float32(y), viewportWidth, float32(y), 1, gridColor, false)
+	}
+
+	x := float32(-e.camera.Position.X)
+	y := float32(-e.camera.Position.Y)
+	vector.StrokeRect(screen, x, y, float32(e.Level.Width), float32(e.Level.Height), 1, levelColor, false)
+}
+
+func (e *Editor) drawPlacements(screen *ebiten.Image) {
+	for i, p := range e.Level.Placements {
+		x, y, w, h := e.bounds(i)
+		sx := float32(x - e.camera.Position.X)
+		sy := float32(y - e.camera.Position.Y)
+
+		clr := boundsColor
+		switch {
+		case i == e.selected:
+			clr = selectColor
+		case p.Kind == assets.PlaceStart:
+			clr = startColor
+		}
+		vector.StrokeRect(screen, sx, sy, float32(w), float32(h), 1, clr, false)
+
+		if p.Kind == assets.PlaceCannon {
+			cx, cy := sx+float32(w)/2, sy+float32(h)/2
+			ex := cx + float32(math.Cos(p.Direction)*w)
+			ey := cy + float32(math.Sin(p.Direction)*h)
+			vector.StrokeLine(screen, cx, cy, ex, ey, 1, clr, false)
+		}
+		if p.Kind == assets.PlaceStart {
+			ebitenutil.DebugPrintAt(screen, "S", int(sx)+5, int(sy))
+		}
+	}
+}
+
+func (e *Editor) drawPanel(screen *ebiten.Image) {
+	left := viewportWidth - panelWidth
+	vector.DrawFilledRect(screen, float32(left), 0, panelWidth, viewportHeight, panelColor, false)
+
+	y := 0
+	line := func(s string) {
+		ebitenutil.DebugPrintAt(screen, s, left+4, y)
+		y += lineHeight
+	}
+
+	line("EDITOR")
+	line(fmt.Sprintf("tool %d: %s", e.tool+1, assets.PlacementKinds[e.tool]))
+
+	target, fields := e.panelTarget()
+	if e.selected >= 0 {
+		line(fmt.Sprintf("-- %s #%d", e.Level.Placements[e.selected].Kind, e.selected))
+	} else {
+		line("-- level")
+	}
+	for i, f := range fields {
+		cursor := " "
+		if i == e.field {
+			cursor = ">"
+		}
+		line(fmt.Sprintf("%s%s %s", cursor, f.Name, formatField(target.FieldByName(f.Name))))
+	}
+
+	y = viewportHeight - 4*lineHeight
+	line("1-7 tool  WASD pan")
+	line("arrows edit  Del")
+	line("^S save  Enter play")
+	if e.messageTicks > 0 {
+		ebitenutil.DebugPrintAt(screen, e.message, 4, viewportHeight-lineHeight)
+	}
+}
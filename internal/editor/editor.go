@@ -0,0 +1,276 @@
+package editor
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+	"math"
+
+	"github.com/hajimehoshi/ebiten/v2"
+	"github.com/hajimehoshi/ebiten/v2/inpututil"
+
+	"github.com/game-jam-2026/dead-jump/internal/assets"
+	"github.com/game-jam-2026/dead-jump/internal/ecs"
+	"github.com/game-jam-2026/dead-jump/internal/ecs/components"
+	"github.com/game-jam-2026/dead-jump/internal/game"
+	"github.com/game-jam-2026/dead-jump/pkg/linalg"
+)
+
+const (
+	ToggleKey   = ebiten.KeyF2
+	DefaultPath = "custom_level.json"
+
+	viewportWidth  = 320
+	viewportHeight = 240
+	panelWidth     = 120
+	panSpeed       = 4
+	messageTicks   = 120
+)
+
+// Editor edits a level file on a tile grid and rebuilds a preview world from
+// the assets constructors after every change.
+type Editor struct {
+	Path  string
+	Level *assets.LevelFile
+
+	active   bool
+	world    *ecs.World
+	entities []ecs.EntityID
+	dirty    bool
+
+	camera components.Camera
+	tool   int
+
+	selected   int
+	dragging   bool
+	dragOffset linalg.Vector2
+	field      int
+
+	message      string
+	messageTicks int
+}
+
+func New(path string) *Editor {
+	e := &Editor{
+		Path:     path,
+		camera:   components.NewCamera(viewportWidth, viewportHeight),
+		selected: -1,
+	}
+	e.load()
+	return e
+}
+
+func (e *Editor) Active() bool {
+	return e.active
+}
+
+func (e *Editor) Open() {
+	e.active = true
+	e.dragging = false
+}
+
+func (e *Editor) Close() {
+	e.active = false
+	e.dragging = false
+}
+
+// Playtest returns a playable world built from the level being edited.
+func (e *Editor) Playtest() *ecs.World {
+	return e.Level.Load(game.CurrentProfile())
+}
+
+// Update handles editor input. It returns true when the user asked to
+// play-test the level.
+func (e *Editor) Update() bool {
+	if e.messageTicks > 0 {
+		e.messageTicks--
+	}
+
+	ctrl := ebiten.IsKeyPressed(ebiten.KeyControl) || ebiten.IsKeyPressed(ebiten.KeyMeta)
+	switch {
+	case ctrl && inpututil.IsKeyJustPressed(ebiten.KeyS):
+		e.save()
+	case ctrl && inpututil.IsKeyJustPressed(ebiten.KeyL):
+		e.load()
+	case inpututil.IsKeyJustPressed(ebiten.KeyEnter):
+		return true
+	}
+
+	if !ctrl {
+		e.updateCamera()
+	}
+	e.updateTool()
+	e.updatePanel()
+	e.updateMouse()
+
+	if inpututil.IsKeyJustPressed(ebiten.KeyDelete) || inpututil.IsKeyJustPressed(ebiten.KeyBackspace) {
+		e.deleteSelected()
+	}
+
+	if e.dirty {
+		e.rebuild()
+	}
+
+	return false
+}
+
+func (e *Editor) updateCamera() {
+	if ebiten.IsKeyPressed(ebiten.KeyA) {
+		e.camera.Position.X -= panSpeed
+	}
+	if ebiten.IsKeyPressed(ebiten.KeyD) {
+		e.camera.Position.X += panSpeed
+	}
+	if ebiten.IsKeyPressed(ebiten.KeyW) {
+		e.camera.Position.Y -= panSpeed
+	}
+	if ebiten.IsKeyPressed(ebiten.KeyS) {
+		e.camera.Position.Y += panSpeed
+	}
+}
+
+func (e *Editor) updateTool() {
+	for i := range assets.PlacementKinds {
+		if inpututil.IsKeyJustPressed(ebiten.Key1 + ebiten.Key(i)) {
+			e.tool = i
+		}
+	}
+}
+
+func (e *Editor) updateMouse() {
+	cx, cy := ebiten.CursorPosition()
+	cursor := e.camera.Position.Add(linalg.Vector2{X: float64(cx), Y: float64(cy)})
+	overPanel := cx >= viewportWidth-panelWidth
+
+	if inpututil.IsMouseButtonJustReleased(ebiten.MouseButtonLeft) {
+		e.dragging = false
+	}
+
+	if e.dragging && ebiten.IsMouseButtonPressed(ebiten.MouseButtonLeft) {
+		p := &e.Level.Placements[e.selected]
+		pos := snap(cursor.Sub(e.dragOffset))
+		if pos.X != p.X || pos.Y != p.Y {
+			p.X, p.Y = pos.X, pos.Y
+			e.dirty = true
+		}
+		return
+	}
+
+	if overPanel {
+		return
+	}
+
+	if inpututil.IsMouseButtonJustPressed(ebiten.MouseButtonLeft) {
+		if i := e.placementAt(cursor); i >= 0 {
+			e.selectPlacement(i)
+			p := e.Level.Placements[i]
+			e.dragOffset = cursor.Sub(linalg.Vector2{X: p.X, Y: p.Y})
+			e.dragging = true
+			return
+		}
+
+		pos := snap(cursor)
+		e.Level.Placements = append(e.Level.Placements, assets.NewPlacement(assets.PlacementKinds[e.tool], pos.X, pos.Y))
+		e.selectPlacement(len(e.Level.Placements) - 1)
+		e.dirty = true
+	}
+
+	if inpututil.IsMouseButtonJustPressed(ebiten.MouseButtonRight) {
+		if i := e.placementAt(cursor); i >= 0 {
+			e.selectPlacement(i)
+			e.deleteSelected()
+		}
+	}
+}
+
+func (e *Editor) selectPlacement(i int) {
+	if e.selected != i {
+		e.field = 0
+	}
+	e.selected = i
+}
+
+func (e *Editor) deleteSelected() {
+	if e.selected < 0 || e.selected >= len(e.Level.Placements) {
+		return
+	}
+	e.Level.Placements = append(e.Level.Placements[:e.selected], e.Level.Placements[e.selected+1:]...)
+	e.selected = -1
+	e.field = 0
+	e.dragging = false
+	e.dirty = true
+}
+
+// placementAt returns the topmost placement under the cursor or -1.
+func (e *Editor) placementAt(p linalg.Vector2) int {
+	for i := len(e.Level.Placements) - 1; i >= 0; i-- {
+		x, y, w, h := e.bounds(i)
+		if p.X >= x && p.X < x+w && p.Y >= y && p.Y < y+h {
+			return i
+		}
+	}
+	return -1
+}
+
+// bounds is the clickable area of a placement: its sprite, or a single tile
+// for entities without one (start point).
+func (e *Editor) bounds(i int) (x, y, w, h float64) {
+	p := e.Level.Placements[i]
+	x, y = p.X, p.Y
+	w, h = float64(assets.TileSize), float64(assets.TileSize)
+
+	if i < len(e.entities) {
+		if sprite, err := ecs.GetComponent[components.Sprite](e.world, e.entities[i]); err == nil && sprite.Image != nil {
+			w, h = sprite.Size()
+		}
+	}
+	return x, y, w, h
+}
+
+func (e *Editor) rebuild() {
+	e.world, e.entities = e.Level.Build()
+	e.dirty = false
+}
+
+func (e *Editor) save() {
+	if err := e.Level.WriteFile(e.Path); err != nil {
+		e.showMessage(fmt.Sprintf("save failed: %v", err))
+		return
+	}
+	e.showMessage("saved " + e.Path)
+}
+
+func (e *Editor) load() {
+	level, err := assets.ReadLevelFile(e.Path)
+	switch {
+	case errors.Is(err, fs.ErrNotExist):
+		level = assets.NewLevelFile()
+		e.showMessage("new level")
+	case err != nil:
+		e.showMessage(err.Error())
+		if e.Level != nil {
+			return
+		}
+		level = assets.NewLevelFile()
+	default:
+		e.showMessage("loaded " + e.Path)
+	}
+
+	e.Level = level
+	e.selected = -1
+	e.field = 0
+	e.rebuild()
+}
+
+func (e *Editor) showMessage(msg string) {
+	e.message = msg
+	e.messageTicks = messageTicks
+}
+
+func snap(p linalg.Vector2) linalg.Vector2 {
+	size := float64(assets.TileSize)
+	return linalg.Vector2{
+		X: math.Floor(p.X/size) * size,
+		Y: math.Floor(p.Y/size) * size,
+	}
+}
@@ -0,0 +1,130 @@
+package editor
+
+import (
+	"fmt"
+	"math"
+	"reflect"
+	"slices"
+
+	"github.com/hajimehoshi/ebiten/v2"
+	"github.com/hajimehoshi/ebiten/v2/inpututil"
+
+	"github.com/game-jam-2026/dead-jump/internal/assets"
+	"github.com/game-jam-2026/dead-jump/internal/ecs/systems"
+)
+
+// panelField is an editable struct field; Step is the increment for numbers
+// and is ignored for bools and strings. Strings cycle through Options, or
+// through the image names when Options is nil.
+type panelField struct {
+	Name    string
+	Step    float64
+	Options func() []string
+}
+
+var positionFields = []panelField{
+	{Name: "X", Step: float64(assets.TileSize)},
+	{Name: "Y", Step: float64(assets.TileSize)},
+}
+
+var placementFields = map[assets.PlacementKind][]panelField{
+	assets.PlaceGround:   {{Name: "Count", Step: 1}, {Name: "Vertical"}},
+	assets.PlacePlatform: {{Name: "Count", Step: 1}, {Name: "Vertical"}},
+	assets.PlaceSpike:    {{Name: "Count", Step: 1}, {Name: "Vertical"}},
+	assets.PlaceCannon: {
+		{Name: "Direction", Step: math.Pi / 8},
+		{Name: "Facing", Step: 1},
+		{Name: "FireRate", Step: 10},
+		{Name: "ProjectileSpeed", Step: 0.5},
+		{Name: "Mode", Step: 1},
+		{Name: "SpreadCount", Step: 1},
+		{Name: "Projectile", Options: systems.ProjectileTypeNames},
+	},
+	assets.PlaceDecoration: {{Name: "Image"}, {Name: "ZIndex", Step: 1}},
+}
+
+var levelFields = []panelField{
+	{Name: "Width", Step: float64(assets.TileSize)},
+	{Name: "Height", Step: float64(assets.TileSize)},
+	{Name: "Lives", Step: 1},
+	{Name: "Sacrifices", Step: 1},
+	{Name: "Backdrop"},
+}
+
+// panelTarget is the struct the side panel edits: the selected placement or,
+// with nothing selected, the level itself.
+func (e *Editor) panelTarget() (reflect.Value, []panelField) {
+	if e.selected >= 0 && e.selected < len(e.Level.Placements) {
+		p := &e.Level.Placements[e.selected]
+		fields := append(slices.Clone(positionFields), placementFields[p.Kind]...)
+		return reflect.ValueOf(p).Elem(), fields
+	}
+	return reflect.ValueOf(e.Level).Elem(), levelFields
+}
+
+func (e *Editor) updatePanel() {
+	_, fields := e.panelTarget()
+
+	if inpututil.IsKeyJustPressed(ebiten.KeyUp) {
+		e.field = (e.field + len(fields) - 1) % len(fields)
+	}
+	if inpututil.IsKeyJustPressed(ebiten.KeyDown) {
+		e.field = (e.field + 1) % len(fields)
+	}
+	e.field = min(e.field, len(fields)-1)
+
+	delta := 0
+	if inpututil.IsKeyJustPressed(ebiten.KeyLeft) {
+		delta = -1
+	}
+	if inpututil.IsKeyJustPressed(ebiten.KeyRight) {
+		delta = 1
+	}
+	if delta != 0 {
+		e.adjustField(delta)
+	}
+}
+
+func (e *Editor) adjustField(delta int) {
+	target, fields := e.panelTarget()
+	field := fields[e.field]
+	v := target.FieldByName(field.Name)
+
+	switch v.Kind() {
+	case reflect.Float64:
+		v.SetFloat(v.Float() + float64(delta)*field.Step)
+	case reflect.Int:
+		v.SetInt(v.Int() + int64(delta)*int64(field.Step))
+	case reflect.Bool:
+		v.SetBool(!v.Bool())
+	case reflect.String:
+		options := assets.Images.Names
+		if field.Options != nil {
+			options = field.Options
+		}
+		v.SetString(cycleOption(options(), v.String(), delta))
+	default:
+		return
+	}
+
+	e.dirty = true
+}
+
+func cycleOption(names []string, current string, delta int) string {
+	if len(names) == 0 {
+		return current
+	}
+
+	i := slices.Index(names, current)
+	if i < 0 {
+		return names[0]
+	}
+	return names[(i+delta+len(names))%len(names)]
+}
+
+func formatField(v reflect.Value) string {
+	if v.Kind() == reflect.Float64 {
+		return fmt.Sprintf("%.4g", v.Float())
+	}
+	return fmt.Sprint(v.Interface())
+}
@@ -0,0 +1,30 @@
+package game
+
+// GameSpeeds are the slow-down steps of the game speed assist.
+var GameSpeeds = []float64{0.5, 0.6, 0.7, 0.8, 0.9, 1}
+
+// Assists help players through the game whatever the difficulty. Runs with
+// any of them on are timed in their own speedrun category.
+type Assists struct {
+	// GameSpeed slows the simulation down, 1 is full speed
+	GameSpeed     float64
+	Invincible    bool
+	InfiniteLives bool
+	// AirControl steers the character harder while airborne
+	AirControl bool
+}
+
+var assists = Assists{GameSpeed: 1}
+
+func CurrentAssists() Assists {
+	return assists
+}
+
+func SetAssists(a Assists) {
+	assists = a
+}
+
+// Any reports whether some assist is on.
+func (a Assists) Any() bool {
+	return a.GameSpeed < 1 || a.Invincible || a.InfiniteLives || a.AirControl
+}
@@ -1,14 +1,31 @@
 package game
 
-// Difficulty represents game difficulty level
+import (
+	"fmt"
+
+	"github.com/game-jam-2026/dead-jump/internal/speedrun"
+)
+
+// Difficulty names one of the preset profiles.
 type Difficulty int
 
 const (
 	DifficultyEasy Difficulty = iota
+	DifficultyNormal
 	DifficultyHard
 )
 
-var currentDifficulty = DifficultyEasy // По умолчанию hard
+var currentDifficulty = DifficultyNormal
+
+func (d Difficulty) String() string {
+	switch d {
+	case DifficultyEasy:
+		return "easy"
+	case DifficultyHard:
+		return "hard"
+	}
+	return "normal"
+}
 
 func GetDifficulty() Difficulty {
 	return currentDifficulty
@@ -18,10 +35,100 @@ func SetDifficulty(d Difficulty) {
 	currentDifficulty = d
 }
 
-func IsEasyMode() bool {
-	return currentDifficulty == DifficultyEasy
+// Profile is everything a difficulty changes. Level loaders receive it; the
+// lives fields set the rules of the whole run.
+type Profile struct {
+	Difficulty Difficulty
+
+	Lives int
+	// MaxLives caps the lives a run can hold, 0 is no cap
+	MaxLives int
+	// LevelBonus is granted on reaching each next level
+	LevelBonus int
+	// Continues refill the lives after a game over, that many times per run
+	Continues int
+
+	// FireRate multiplies how often cannons fire, ProjectileSpeed how fast
+	// their shots fly
+	FireRate        float64
+	ProjectileSpeed float64
+	// CorpseDurability is how many hits a corpse takes before it crumbles,
+	// -1 for never
+	CorpseDurability int64
+	// RespawnGrace is how many ticks a respawned character is invulnerable
+	RespawnGrace int
+}
+
+var profiles = map[Difficulty]Profile{
+	DifficultyEasy: {
+		Difficulty:       DifficultyEasy,
+		Lives:            10,
+		MaxLives:         20,
+		LevelBonus:       2,
+		Continues:        5,
+		FireRate:         0.7,
+		ProjectileSpeed:  0.8,
+		CorpseDurability: -1,
+		RespawnGrace:     90,
+	},
+	DifficultyNormal: {
+		Difficulty:       DifficultyNormal,
+		Lives:            8,
+		MaxLives:         15,
+		LevelBonus:       1,
+		Continues:        3,
+		FireRate:         1,
+		ProjectileSpeed:  1,
+		CorpseDurability: -1,
+		RespawnGrace:     30,
+	},
+	DifficultyHard: {
+		Difficulty:       DifficultyHard,
+		Lives:            5,
+		MaxLives:         9,
+		FireRate:         1.3,
+		ProjectileSpeed:  1.2,
+		CorpseDurability: 3,
+	},
+}
+
+// ProfileFor returns the preset of a difficulty.
+func ProfileFor(d Difficulty) Profile {
+	if p, ok := profiles[d]; ok {
+		return p
+	}
+	return profiles[DifficultyNormal]
 }
 
-func IsHardMode() bool {
-	return currentDifficulty == DifficultyHard
+// CurrentProfile is the preset of the difficulty picked in the menu.
+func CurrentProfile() Profile {
+	return ProfileFor(currentDifficulty)
+}
+
+// Changes describes the profile in short lines for the difficulty menu.
+func (p Profile) Changes() []string {
+	continues := "NO CONTINUES"
+	if p.Continues > 0 {
+		continues = fmt.Sprintf("%d CONTINUES", p.Continues)
+	}
+	maxLives := "NO LIVES CAP"
+	if p.MaxLives > 0 {
+		maxLives = fmt.Sprintf("AT MOST %d LIVES", p.MaxLives)
+	}
+	grace := "NO RESPAWN GRACE"
+	if p.RespawnGrace > 0 {
+		grace = fmt.Sprintf("%.1fS RESPAWN GRACE", float64(p.RespawnGrace)/speedrun.TicksPerSecond)
+	}
+	corpses := "CORPSES NEVER BREAK"
+	if p.CorpseDurability >= 0 {
+		corpses = fmt.Sprintf("CORPSES BREAK AFTER %d HITS", p.CorpseDurability)
+	}
+
+	return []string{
+		fmt.Sprintf("%d LIVES +%d PER LEVEL, %s", p.Lives, p.LevelBonus, continues),
+		maxLives,
+		grace,
+		fmt.Sprintf("CANNONS x%.1f, SHOTS x%.1f", p.FireRate, p.ProjectileSpeed),
+		corpses,
+	}
 }
@@ -0,0 +1,13 @@
+package game
+
+var echoes bool
+
+// IsEchoesOn reports whether previous lives come back as echoes replaying
+// their inputs.
+func IsEchoesOn() bool {
+	return echoes
+}
+
+func SetEchoes(on bool) {
+	echoes = on
+}
@@ -0,0 +1,25 @@
+package game
+
+var (
+	speedrunTimer bool
+	countMenuTime bool
+)
+
+// IsSpeedrunTimerOn reports whether runs are timed and split.
+func IsSpeedrunTimerOn() bool {
+	return speedrunTimer
+}
+
+func SetSpeedrunTimer(on bool) {
+	speedrunTimer = on
+}
+
+// CountsMenuTime reports whether the run timer keeps going in pause and
+// level-complete screens.
+func CountsMenuTime() bool {
+	return countMenuTime
+}
+
+func SetCountMenuTime(on bool) {
+	countMenuTime = on
+}
@@ -0,0 +1,130 @@
+// Package ghost records the character's run through a level, keeps the
+// fastest one and replays it as a ghost to race against.
+package ghost
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"path"
+
+	"github.com/game-jam-2026/dead-jump/internal/assets"
+	"github.com/game-jam-2026/dead-jump/internal/ecs"
+	"github.com/game-jam-2026/dead-jump/internal/ecs/components"
+	"github.com/game-jam-2026/dead-jump/internal/ecs/systems"
+	"github.com/game-jam-2026/dead-jump/internal/save"
+)
+
+// Run is one recorded attempt; it is also the format of ghost files shared
+// between players.
+type Run struct {
+	Level  string                  `json:"level"`
+	Frames []components.GhostFrame `json:"frames"`
+}
+
+func (r *Run) Ticks() int {
+	return len(r.Frames)
+}
+
+func bestName(level string) string {
+	return "ghost-" + level
+}
+
+func importedName(level string) string {
+	return "ghost-import-" + level
+}
+
+func load(name string) (*Run, bool) {
+	var run Run
+	if err := save.Load(name, &run); err != nil {
+		fmt.Println("ghost: ignoring broken run:", err)
+		return nil, false
+	}
+	return &run, len(run.Frames) > 0
+}
+
+// Best returns the player's own fastest run of level.
+func Best(level string) (*Run, bool) {
+	return load(bestName(level))
+}
+
+// Rival returns the run to race on level: an imported ghost if there is
+// one, the player's own best otherwise.
+func Rival(level string) (*Run, bool) {
+	if run, ok := load(importedName(level)); ok {
+		return run, true
+	}
+	return Best(level)
+}
+
+// Prepare starts recording the attempt in w and spawns the rival ghost.
+func Prepare(w *ecs.World, level string) {
+	w.SetResource(components.GhostRecording{})
+
+	if run, ok := Rival(level); ok {
+		assets.CreateGhost(w, run.Frames, systems.GhostAlpha)
+	}
+}
+
+// Keep stores the attempt recorded in w if it is the fastest so far and
+// reports whether it was.
+func Keep(w *ecs.World, level string) bool {
+	recording, err := ecs.GetResource[components.GhostRecording](w)
+	if err != nil || len(recording.Frames) == 0 {
+		return false
+	}
+
+	if best, ok := Best(level); ok && best.Ticks() <= len(recording.Frames) {
+		return false
+	}
+
+	run := Run{Level: level, Frames: recording.Frames}
+	if err := save.Store(bestName(level), run); err != nil {
+		fmt.Println("ghost:", err)
+		return false
+	}
+	return true
+}
+
+// Import reads a ghost file and races it on its level from now on.
+func Import(data []byte) (*Run, error) {
+	var run Run
+	if err := json.Unmarshal(data, &run); err != nil {
+		return nil, err
+	}
+	if run.Level == "" || len(run.Frames) == 0 {
+		return nil, errors.New("not a ghost file")
+	}
+
+	if err := save.Store(importedName(run.Level), run); err != nil {
+		return nil, err
+	}
+	return &run, nil
+}
+
+// ImportFS imports every .json ghost file in fsys, e.g. files dropped on the
+// game window. Files that aren't ghosts are skipped.
+func ImportFS(fsys fs.FS) []*Run {
+	var runs []*Run
+	_ = fs.WalkDir(fsys, ".", func(name string, d fs.DirEntry, err error) error {
+		if err != nil || d.IsDir() || path.Ext(name) != ".json" {
+			return nil
+		}
+
+		data, err := fs.ReadFile(fsys, name)
+		if err != nil {
+			fmt.Println("ghost:", name, err)
+			return nil
+		}
+
+		run, err := Import(data)
+		if err != nil {
+			fmt.Println("ghost:", name, err)
+			return nil
+		}
+		runs = append(runs, run)
+		return nil
+	})
+	return runs
+}
@@ -0,0 +1,133 @@
+// Package hud draws the in-game overlay: lives, deaths, timer and
+// collectibles. Widgets only read the world, they never add entities to it.
+package hud
+
+import (
+	"bytes"
+
+	"github.com/hajimehoshi/ebiten/v2"
+	"github.com/hajimehoshi/ebiten/v2/text/v2"
+
+	"github.com/game-jam-2026/dead-jump/internal/ecs"
+	"github.com/game-jam-2026/dead-jump/internal/menu"
+	"github.com/game-jam-2026/dead-jump/pkg/linalg"
+)
+
+type Anchor int
+
+const (
+	AnchorTopLeft Anchor = iota
+	AnchorTopCenter
+	AnchorTopRight
+	AnchorBottomLeft
+	AnchorBottomCenter
+	AnchorBottomRight
+)
+
+// Widget is one HUD element. Size is in unscaled pixels; Draw gets a
+// transform that already places and scales the widget.
+type Widget interface {
+	Update(w *ecs.World)
+	// Reset drops the remembered values so a freshly loaded level doesn't
+	// animate its initial state.
+	Reset()
+	Size() (float64, float64)
+	Draw(screen *ebiten.Image, geo ebiten.GeoM)
+}
+
+type slot struct {
+	widget Widget
+	anchor Anchor
+	margin linalg.Vector2
+}
+
+type HUD struct {
+	// Scale applies to every widget; margins are kept unscaled so widgets
+	// stay glued to the screen edges.
+	Scale float64
+
+	slots []slot
+	world *ecs.World
+}
+
+var font *text.GoTextFace
+
+func init() {
+	source, err := text.NewGoTextFaceSource(bytes.NewReader(menu.FontData()))
+	if err != nil {
+		panic(err)
+	}
+	font = &text.GoTextFace{Source: source, Size: 8}
+}
+
+// New returns the HUD with the default layout.
+func New() *HUD {
+	h := &HUD{Scale: 1}
+	h.Add(NewLives(), AnchorTopLeft, linalg.Vector2{X: 6, Y: 6})
+	h.Add(NewTimer(), AnchorTopCenter, linalg.Vector2{Y: 6})
+	h.Add(NewCollectibles(), AnchorTopRight, linalg.Vector2{X: 6, Y: 6})
+	h.Add(NewDeaths(), AnchorBottomLeft, linalg.Vector2{X: 6, Y: 6})
+	// one line above the bottom center slot, which the run timer takes
+	h.Add(NewSacrifices(), AnchorBottomCenter, linalg.Vector2{Y: 18})
+	h.Add(NewCorpses(), AnchorBottomRight, linalg.Vector2{X: 6, Y: 6})
+	return h
+}
+
+// Add places widget at anchor, margin pixels away from the screen edges.
+func (h *HUD) Add(widget Widget, anchor Anchor, margin linalg.Vector2) {
+	h.slots = append(h.slots, slot{widget: widget, anchor: anchor, margin: margin})
+}
+
+// Update refreshes widget values once per tick.
+func (h *HUD) Update(w *ecs.World) {
+	if w != h.world {
+		h.world = w
+		for _, s := range h.slots {
+			s.widget.Reset()
+		}
+	}
+
+	for _, s := range h.slots {
+		s.widget.Update(w)
+	}
+}
+
+func (h *HUD) Draw(screen *ebiten.Image) {
+	for _, s := range h.slots {
+		width, height := s.widget.Size()
+		if width == 0 || height == 0 {
+			continue
+		}
+
+		pos := h.place(s, width*h.Scale, height*h.Scale)
+
+		var geo ebiten.GeoM
+		geo.Scale(h.Scale, h.Scale)
+		geo.Translate(pos.X, pos.Y)
+		s.widget.Draw(screen, geo)
+	}
+}
+
+// place returns the top-left corner of a widget of the given scaled size.
+func (h *HUD) place(s slot, width, height float64) linalg.Vector2 {
+	screenW, screenH := float64(menu.ScreenWidth), float64(menu.ScreenHeight)
+
+	var pos linalg.Vector2
+	switch s.anchor {
+	case AnchorTopLeft, AnchorBottomLeft:
+		pos.X = s.margin.X
+	case AnchorTopCenter, AnchorBottomCenter:
+		pos.X = (screenW-width)/2 + s.margin.X
+	case AnchorTopRight, AnchorBottomRight:
+		pos.X = screenW - width - s.margin.X
+	}
+
+	switch s.anchor {
+	case AnchorTopLeft, AnchorTopCenter, AnchorTopRight:
+		pos.Y = s.margin.Y
+	default:
+		pos.Y = screenH - height - s.margin.Y
+	}
+
+	return pos
+}
@@ -0,0 +1,60 @@
+package hud
+
+import (
+	"github.com/hajimehoshi/ebiten/v2"
+)
+
+const pulseTicks = 20
+
+// pulse remembers a value and animates a short pop whenever it changes.
+type pulse struct {
+	value int
+	seen  bool
+	ticks int
+	// gained is true when the value went up, which flashes a different color
+	gained bool
+}
+
+func (p *pulse) set(v int) {
+	if p.seen && v != p.value {
+		p.ticks = pulseTicks
+		p.gained = v > p.value
+	}
+	p.value = v
+	p.seen = true
+
+	if p.ticks > 0 {
+		p.ticks--
+	}
+}
+
+func (p *pulse) reset() {
+	*p = pulse{}
+}
+
+// scale grows the widget right after a change and eases back to 1.
+func (p *pulse) scale() float64 {
+	return 1 + 0.5*float64(p.ticks)/pulseTicks
+}
+
+func (p *pulse) tint(cs *ebiten.ColorScale) {
+	if p.ticks == 0 {
+		return
+	}
+	t := float32(p.ticks) / pulseTicks
+	if p.gained {
+		cs.Scale(1, 1, 1-0.6*t, 1)
+	} else {
+		cs.Scale(1, 1-0.7*t, 1-0.7*t, 1)
+	}
+}
+
+// around scales geo by s around the center of a width x height box.
+func around(geo ebiten.GeoM, width, height, s float64) ebiten.GeoM {
+	var local ebiten.GeoM
+	local.Translate(-width/2, -height/2)
+	local.Scale(s, s)
+	local.Translate(width/2, height/2)
+	local.Concat(geo)
+	return local
+}
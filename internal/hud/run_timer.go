@@ -0,0 +1,99 @@
+package hud
+
+import (
+	"image/color"
+
+	"github.com/hajimehoshi/ebiten/v2"
+	"github.com/hajimehoshi/ebiten/v2/text/v2"
+
+	"github.com/game-jam-2026/dead-jump/internal/ecs"
+	"github.com/game-jam-2026/dead-jump/internal/speedrun"
+)
+
+var (
+	colorAhead  = color.RGBA{90, 200, 90, 255}
+	colorBehind = color.RGBA{220, 70, 60, 255}
+	colorGold   = color.RGBA{255, 210, 60, 255}
+)
+
+// RunTimer shows the speedrun time and how it compares to the personal
+// best; it pops on every split, gold for a best segment.
+type RunTimer struct {
+	timer *speedrun.Timer
+
+	text  string
+	delta string
+	color color.RGBA
+	gold  bool
+	pulse pulse
+}
+
+func NewRunTimer(timer *speedrun.Timer) *RunTimer {
+	return &RunTimer{timer: timer}
+}
+
+func (r *RunTimer) Update(_ *ecs.World) {
+	t := r.timer
+	if !t.Running && !t.Finished {
+		r.text = ""
+		return
+	}
+
+	r.pulse.set(len(t.Splits))
+	r.gold = t.IsGold(len(t.Splits) - 1)
+
+	r.text = speedrun.FormatTicks(t.Ticks)
+	r.delta = ""
+	r.color = textColor
+
+	delta, ok := t.Delta()
+	if !ok {
+		return
+	}
+	r.delta = speedrun.FormatDelta(delta)
+	r.color = colorBehind
+	if delta < 0 {
+		r.color = colorAhead
+	}
+}
+
+func (r *RunTimer) Reset() {}
+
+func (r *RunTimer) line() string {
+	if r.delta == "" {
+		return r.text
+	}
+	return r.text + " " + r.delta
+}
+
+func (r *RunTimer) Size() (float64, float64) {
+	if r.text == "" {
+		return 0, 0
+	}
+	return text.Measure(r.line(), font, 0)
+}
+
+func (r *RunTimer) Draw(screen *ebiten.Image, geo ebiten.GeoM) {
+	width, height := r.Size()
+	geo = around(geo, width, height, r.pulse.scale())
+
+	op := &text.DrawOptions{}
+	op.GeoM = geo
+	op.ColorScale.ScaleWithColor(textColor)
+	if r.pulse.ticks > 0 && r.gold {
+		op.ColorScale.Reset()
+		op.ColorScale.ScaleWithColor(colorGold)
+	}
+	text.Draw(screen, r.text, font, op)
+
+	if r.delta == "" {
+		return
+	}
+
+	textW, _ := text.Measure(r.text+" ", font, 0)
+	op = &text.DrawOptions{}
+	op.GeoM.Translate(textW, 0)
+	op.GeoM.Concat(geo)
+	op.ColorScale.ScaleWithColor(r.color)
+	text.Draw(screen, r.delta, font, op)
+}
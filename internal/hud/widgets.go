@@ -0,0 +1,248 @@
+package hud
+
+import (
+	"fmt"
+	"image/color"
+	"reflect"
+
+	"github.com/hajimehoshi/ebiten/v2"
+	"github.com/hajimehoshi/ebiten/v2/text/v2"
+
+	"github.com/game-jam-2026/dead-jump/internal/assets"
+	"github.com/game-jam-2026/dead-jump/internal/ecs"
+	"github.com/game-jam-2026/dead-jump/internal/ecs/components"
+	"github.com/game-jam-2026/dead-jump/internal/speedrun"
+)
+
+const iconGap = 2
+
+var textColor = color.RGBA{200, 180, 160, 255}
+
+// Lives draws one icon per remaining life; a lost life fades out in place.
+type Lives struct {
+	Icon *ebiten.Image
+
+	count int
+	pulse pulse
+}
+
+func NewLives() *Lives {
+	return &Lives{Icon: assets.Image(assets.ImageOrange)}
+}
+
+func (l *Lives) Update(w *ecs.World) {
+	l.count = 0
+	counters := w.GetEntities(reflect.TypeOf((*components.Life)(nil)).Elem())
+	if len(counters) > 0 {
+		if life, err := ecs.GetComponent[components.Life](w, counters[0]); err == nil {
+			l.count = max(0, life.Count)
+		}
+	}
+	l.pulse.set(l.count)
+}
+
+func (l *Lives) Reset() {
+	l.pulse.reset()
+}
+
+func (l *Lives) slots() int {
+	if l.pulse.ticks > 0 && !l.pulse.gained {
+		return l.count + 1
+	}
+	return l.count
+}
+
+func (l *Lives) Size() (float64, float64) {
+	n := l.slots()
+	if n == 0 {
+		return 0, 0
+	}
+	b := l.Icon.Bounds()
+	return float64(n*(b.Dx()+iconGap) - iconGap), float64(b.Dy())
+}
+
+func (l *Lives) Draw(screen *ebiten.Image, geo ebiten.GeoM) {
+	b := l.Icon.Bounds()
+	iconW, iconH := float64(b.Dx()), float64(b.Dy())
+
+	for i := range l.slots() {
+		var local ebiten.GeoM
+		local.Translate(float64(i)*(iconW+iconGap), 0)
+		local.Concat(geo)
+
+		op := &ebiten.DrawImageOptions{}
+		op.GeoM = local
+
+		last := i == l.slots()-1
+		if last && l.pulse.ticks > 0 {
+			if l.pulse.gained {
+				op.GeoM = around(local, iconW, iconH, l.pulse.scale())
+			} else {
+				// потерянная жизнь тает и уезжает вверх
+				t := float64(l.pulse.ticks) / pulseTicks
+				op.GeoM = around(local, iconW, iconH, 2-t)
+				op.GeoM.Translate(0, -(1-t)*6)
+				op.ColorScale.ScaleAlpha(float32(t))
+			}
+			l.pulse.tint(&op.ColorScale)
+		}
+
+		screen.DrawImage(l.Icon, op)
+	}
+}
+
+// Counter is an optional icon followed by a label and a number, popping
+// whenever the number changes.
+type Counter struct {
+	Icon  *ebiten.Image
+	Label string
+
+	read  func(w *ecs.World) (value, total int, ok bool)
+	text  string
+	pulse pulse
+}
+
+// NewDeaths counts deaths since the level was loaded.
+func NewDeaths() *Counter {
+	return &Counter{
+		Label: "DEATHS",
+		read: func(w *ecs.World) (int, int, bool) {
+			stats, err := ecs.GetResource[components.LevelStats](w)
+			if err != nil {
+				return 0, 0, true
+			}
+			return stats.Deaths, 0, true
+		},
+	}
+}
+
+// NewCorpses counts the corpses lying around the level.
+func NewCorpses() *Counter {
+	return &Counter{
+		Label: "CORPSES",
+		read: func(w *ecs.World) (int, int, bool) {
+			corpses := w.GetEntities(reflect.TypeOf((*components.Corpse)(nil)).Elem())
+			return len(corpses), 0, true
+		},
+	}
+}
+
+// NewSacrifices shows the sacrifices left in levels that limit them.
+func NewSacrifices() *Counter {
+	return &Counter{
+		Label: "SACRIFICES",
+		read: func(w *ecs.World) (int, int, bool) {
+			sacrifices, err := ecs.GetResource[components.Sacrifices](w)
+			if err != nil || sacrifices.Disabled {
+				return 0, 0, false
+			}
+			left, ok := sacrifices.Left()
+			return left, 0, ok
+		},
+	}
+}
+
+// NewCollectibles shows found/total; levels without collectibles hide it.
+func NewCollectibles() *Counter {
+	return &Counter{
+		Icon: assets.CollectibleImage(components.CollectibleFruit),
+		read: func(w *ecs.World) (int, int, bool) {
+			collection, err := ecs.GetResource[components.Collection](w)
+			if err != nil || collection.Total == 0 {
+				return 0, 0, false
+			}
+			return len(collection.Found), collection.Total, true
+		},
+	}
+}
+
+func (c *Counter) Update(w *ecs.World) {
+	value, total, ok := c.read(w)
+	if !ok {
+		c.text = ""
+		return
+	}
+
+	c.pulse.set(value)
+	c.text = fmt.Sprint(value)
+	if total > 0 {
+		c.text = fmt.Sprintf("%d/%d", value, total)
+	}
+	if c.Label != "" {
+		c.text = c.Label + " " + c.text
+	}
+}
+
+func (c *Counter) Reset() {
+	c.pulse.reset()
+}
+
+func (c *Counter) iconSize() (float64, float64) {
+	if c.Icon == nil {
+		return 0, 0
+	}
+	b := c.Icon.Bounds()
+	return float64(b.Dx() + iconGap), float64(b.Dy())
+}
+
+func (c *Counter) Size() (float64, float64) {
+	if c.text == "" {
+		return 0, 0
+	}
+	iconW, iconH := c.iconSize()
+	textW, textH := text.Measure(c.text, font, 0)
+	return iconW + textW, max(iconH, textH)
+}
+
+func (c *Counter) Draw(screen *ebiten.Image, geo ebiten.GeoM) {
+	width, height := c.Size()
+	geo = around(geo, width, height, c.pulse.scale())
+
+	if c.Icon != nil {
+		op := &ebiten.DrawImageOptions{}
+		op.GeoM = geo
+		screen.DrawImage(c.Icon, op)
+	}
+
+	iconW, _ := c.iconSize()
+	op := &text.DrawOptions{}
+	op.GeoM.Translate(iconW, 0)
+	op.GeoM.Concat(geo)
+	op.ColorScale.ScaleWithColor(textColor)
+	c.pulse.tint(&op.ColorScale)
+	text.Draw(screen, c.text, font, op)
+}
+
+// Timer shows the time spent in the level.
+type Timer struct {
+	text string
+}
+
+func NewTimer() *Timer {
+	return &Timer{}
+}
+
+func (t *Timer) Update(w *ecs.World) {
+	stats, err := ecs.GetResource[components.LevelStats](w)
+	if err != nil {
+		t.text = ""
+		return
+	}
+	t.text = speedrun.FormatTicks(stats.Ticks)
+}
+
+func (t *Timer) Reset() {}
+
+func (t *Timer) Size() (float64, float64) {
+	if t.text == "" {
+		return 0, 0
+	}
+	return text.Measure(t.text, font, 0)
+}
+
+func (t *Timer) Draw(screen *ebiten.Image, geo ebiten.GeoM) {
+	op := &text.DrawOptions{}
+	op.GeoM = geo
+	op.ColorScale.ScaleWithColor(textColor)
+	text.Draw(screen, t.text, font, op)
+}
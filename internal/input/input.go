@@ -0,0 +1,88 @@
+// Package input turns keyboard state or recorded demos into the Input
+// resource the character systems read.
+package input
+
+import (
+	"github.com/hajimehoshi/ebiten/v2"
+
+	"github.com/game-jam-2026/dead-jump/internal/ecs"
+	"github.com/game-jam-2026/dead-jump/internal/ecs/components"
+)
+
+// Source provides the buttons held on each simulation tick.
+type Source interface {
+	Poll() components.Button
+}
+
+// Apply stores buttons as the world's Input for this tick. Presses are
+// derived from the previous tick, so a replayed recording presses exactly
+// when the original did.
+func Apply(w *ecs.World, held components.Button) {
+	var previous components.Button
+	if in, err := ecs.GetResource[components.Input](w); err == nil {
+		previous = in.Held
+	}
+	w.SetResource(components.Input{
+		Held:    held,
+		Pressed: held &^ previous,
+	})
+}
+
+// Keyboard reads the player's keys.
+type Keyboard struct{}
+
+func (Keyboard) Poll() components.Button {
+	var held components.Button
+	if ebiten.IsKeyPressed(ebiten.KeyLeft) || ebiten.IsKeyPressed(ebiten.KeyA) {
+		held |= components.ButtonLeft
+	}
+	if ebiten.IsKeyPressed(ebiten.KeyRight) || ebiten.IsKeyPressed(ebiten.KeyD) {
+		held |= components.ButtonRight
+	}
+	if ebiten.IsKeyPressed(ebiten.KeySpace) || ebiten.IsKeyPressed(ebiten.KeyW) || ebiten.IsKeyPressed(ebiten.KeyUp) {
+		held |= components.ButtonJump
+	}
+	if ebiten.IsKeyPressed(ebiten.KeyX) || ebiten.IsKeyPressed(ebiten.KeyS) || ebiten.IsKeyPressed(ebiten.KeyDown) {
+		held |= components.ButtonSacrifice
+	}
+	return held
+}
+
+// Recorder passes another source through and remembers every tick.
+type Recorder struct {
+	Source Source
+	Ticks  []components.Button
+}
+
+func NewRecorder(source Source) *Recorder {
+	return &Recorder{Source: source}
+}
+
+func (r *Recorder) Poll() components.Button {
+	held := r.Source.Poll()
+	r.Ticks = append(r.Ticks, held)
+	return held
+}
+
+// Playback replays recorded ticks and holds nothing afterwards.
+type Playback struct {
+	Ticks []components.Button
+	tick  int
+}
+
+func NewPlayback(ticks []components.Button) *Playback {
+	return &Playback{Ticks: ticks}
+}
+
+func (p *Playback) Poll() components.Button {
+	if p.Done() {
+		return 0
+	}
+	held := p.Ticks[p.tick]
+	p.tick++
+	return held
+}
+
+func (p *Playback) Done() bool {
+	return p.tick >= len(p.Ticks)
+}
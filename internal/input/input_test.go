@@ -0,0 +1,65 @@
+package input
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/game-jam-2026/dead-jump/internal/ecs"
+	"github.com/game-jam-2026/dead-jump/internal/ecs/components"
+)
+
+func TestRecordAndReplay(t *testing.T) {
+	recorded := []components.Button{
+		0,
+		components.ButtonRight,
+		components.ButtonRight | components.ButtonJump,
+		components.ButtonJump,
+	}
+
+	rec := NewRecorder(NewPlayback(recorded))
+	for range recorded {
+		rec.Poll()
+	}
+	if !slices.Equal(rec.Ticks, recorded) {
+		t.Fatalf("recorded %v, want %v", rec.Ticks, recorded)
+	}
+
+	playback := NewPlayback(rec.Ticks)
+	for i, want := range recorded {
+		if playback.Done() {
+			t.Fatalf("done after %d ticks", i)
+		}
+		if got := playback.Poll(); got != want {
+			t.Errorf("tick %d = %v, want %v", i, got, want)
+		}
+	}
+	if !playback.Done() || playback.Poll() != 0 {
+		t.Error("playback holds buttons past the recording")
+	}
+}
+
+func TestApplyDerivesPresses(t *testing.T) {
+	tests := []struct {
+		held    components.Button
+		pressed components.Button
+	}{
+		{components.ButtonRight, components.ButtonRight},
+		{components.ButtonRight | components.ButtonJump, components.ButtonJump},
+		{components.ButtonRight | components.ButtonJump, 0},
+		{components.ButtonJump, 0},
+		{0, 0},
+		{components.ButtonJump, components.ButtonJump},
+	}
+
+	w := ecs.NewWorld()
+	for i, tt := range tests {
+		Apply(w, tt.held)
+		in, err := ecs.GetResource[components.Input](w)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if in.Held != tt.held || in.Pressed != tt.pressed {
+			t.Errorf("tick %d: got %+v, want held %v pressed %v", i, *in, tt.held, tt.pressed)
+		}
+	}
+}
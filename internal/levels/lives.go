@@ -0,0 +1,44 @@
+package levels
+
+import (
+	"reflect"
+
+	"github.com/game-jam-2026/dead-jump/internal/assets"
+	"github.com/game-jam-2026/dead-jump/internal/ecs"
+	"github.com/game-jam-2026/dead-jump/internal/ecs/components"
+	"github.com/game-jam-2026/dead-jump/internal/game"
+)
+
+// clampLives keeps the lives of a run within the profile's cap.
+func clampLives(p game.Profile, lives int) int {
+	if p.MaxLives > 0 {
+		lives = min(lives, p.MaxLives)
+	}
+	return max(0, lives)
+}
+
+// livesIn reads the life counter of w.
+func livesIn(w *ecs.World) (int, bool) {
+	if w == nil {
+		return 0, false
+	}
+	counters := w.GetEntities(reflect.TypeOf((*components.Life)(nil)).Elem())
+	if len(counters) == 0 {
+		return 0, false
+	}
+	life, err := ecs.GetComponent[components.Life](w, counters[0])
+	if err != nil {
+		return 0, false
+	}
+	return life.Count, true
+}
+
+// setLives replaces whatever life counter the level loader created.
+func setLives(w *ecs.World, lives int) {
+	counters := w.GetEntities(reflect.TypeOf((*components.Life)(nil)).Elem())
+	if len(counters) == 0 {
+		assets.CreateLifeCounter(w, lives)
+		return
+	}
+	w.SetComponent(counters[0], components.Life{Count: lives})
+}
@@ -1,23 +1,55 @@
 package levels
 
 import (
+	"fmt"
+
 	"github.com/game-jam-2026/dead-jump/internal/assets"
 	"github.com/game-jam-2026/dead-jump/internal/ecs"
+	"github.com/game-jam-2026/dead-jump/internal/ecs/components"
+	"github.com/game-jam-2026/dead-jump/internal/ecs/systems"
+	"github.com/game-jam-2026/dead-jump/internal/game"
+	"github.com/game-jam-2026/dead-jump/internal/ghost"
+	"github.com/game-jam-2026/dead-jump/internal/save"
 )
 
-type LevelLoader func() *ecs.World
+type LevelLoader func(p game.Profile) *ecs.World
+
+// Level is one entry of the campaign. Name keys the saved progress; secret
+// levels are only played once every earlier level is fully collected.
+// Levels with FixedLives always start with that many, apart from the run's.
+type Level struct {
+	Name       string
+	Load       LevelLoader
+	Secret     bool
+	FixedLives int
+}
 
-var LevelSequence = []LevelLoader{
-	assets.LoadLoreDumpLevel,
-	assets.LoadLevel1,
-	assets.LoadLevel2,
-	assets.Load2CannonsLevel,
-	assets.LoadTowerLevel,
-	assets.LoadEpilogueLevel,
+var LevelSequence = []Level{
+	{Name: "lore", Load: assets.LoadLoreDumpLevel},
+	{Name: "level1", Load: assets.LoadLevel1},
+	{Name: "level2", Load: assets.LoadLevel2},
+	{Name: "2cannons", Load: assets.Load2CannonsLevel},
+	{Name: "tower", Load: assets.LoadTowerLevel},
+	{Name: "epilogue", Load: assets.LoadEpilogueLevel, Secret: true, FixedLives: 1},
 }
 
+// Find looks a level up by name.
+func Find(name string) (Level, bool) {
+	for _, level := range LevelSequence {
+		if level.Name == name {
+			return level, true
+		}
+	}
+	return Level{}, false
+}
+
+// Manager walks the level sequence and owns the run's lives.
 type Manager struct {
 	currentLevel int
+
+	profile   game.Profile
+	lives     int
+	continues int
 }
 
 func NewManager() *Manager {
@@ -26,30 +58,160 @@ func NewManager() *Manager {
 	}
 }
 
+// StartGame starts a run with the profile of the current difficulty.
 func (m *Manager) StartGame() *ecs.World {
+	m.newRun()
 	m.currentLevel = 0
-	return LevelSequence[0]()
+	return m.load(0)
+}
+
+func (m *Manager) newRun() {
+	m.profile = game.CurrentProfile()
+	m.lives = m.profile.Lives
+	m.continues = m.profile.Continues
 }
 
 func (m *Manager) NextLevel() *ecs.World {
 	m.currentLevel++
+	for m.currentLevel < len(LevelSequence) && !m.unlocked(m.currentLevel) {
+		m.currentLevel++
+	}
 	if m.currentLevel >= len(LevelSequence) {
 		return nil
 	}
-	return LevelSequence[m.currentLevel]()
+	m.lives = clampLives(m.profile, m.lives+m.profile.LevelBonus)
+	return m.load(m.currentLevel)
 }
 
-func (m *Manager) RestartLevel() *ecs.World {
+// RestartLevel rebuilds the current level, keeping the lives left in w.
+func (m *Manager) RestartLevel(w *ecs.World) *ecs.World {
 	if m.currentLevel < 0 || m.currentLevel >= len(LevelSequence) {
 		return nil
 	}
-	return LevelSequence[m.currentLevel]()
+	m.keepLives(w)
+	return m.load(m.currentLevel)
+}
+
+// Continue spends a continue after a game over: the lives are refilled and
+// the level starts over. Returns nil when no continues are left.
+func (m *Manager) Continue() *ecs.World {
+	if m.continues <= 0 || m.currentLevel < 0 || m.currentLevel >= len(LevelSequence) {
+		return nil
+	}
+	m.continues--
+	m.lives = m.profile.Lives
+	return m.load(m.currentLevel)
+}
+
+// Continues is how many game overs the run can still continue from.
+func (m *Manager) Continues() int {
+	return m.continues
+}
+
+// keepLives takes the run's lives over from a world of the current level.
+func (m *Manager) keepLives(w *ecs.World) {
+	if LevelSequence[m.currentLevel].FixedLives > 0 {
+		return
+	}
+	if lives, ok := livesIn(w); ok && lives > 0 {
+		m.lives = clampLives(m.profile, lives)
+	}
+}
+
+// JumpTo loads the level at the given LevelSequence index.
+func (m *Manager) JumpTo(index int) (*ecs.World, error) {
+	if index < 0 || index >= len(LevelSequence) {
+		return nil, fmt.Errorf("level index %d out of range [0, %d)", index, len(LevelSequence))
+	}
+	if m.profile.Lives == 0 {
+		m.newRun()
+	}
+	m.currentLevel = index
+	return m.load(index), nil
+}
+
+// Current returns the level being played; the zero Level before StartGame.
+func (m *Manager) Current() Level {
+	if m.currentLevel < 0 || m.currentLevel >= len(LevelSequence) {
+		return Level{}
+	}
+	return LevelSequence[m.currentLevel]
 }
 
 func (m *Manager) HasNextLevel() bool {
-	return m.currentLevel < len(LevelSequence)-1
+	for i := m.currentLevel + 1; i < len(LevelSequence); i++ {
+		if m.unlocked(i) {
+			return true
+		}
+	}
+	return false
 }
 
 func (m *Manager) Reset() {
 	m.currentLevel = -1
 }
+
+// CompleteLevel saves the current level as finished along with the
+// collectibles found in w, keeps the attempt's ghost if it was the fastest
+// and carries the lives left on to the next level.
+func (m *Manager) CompleteLevel(w *ecs.World) {
+	if m.currentLevel < 0 || m.currentLevel >= len(LevelSequence) {
+		return
+	}
+
+	level := LevelSequence[m.currentLevel]
+	progress := save.Current()
+
+	// worlds not loaded by the manager (demo recordings) aren't progress
+	collection, err := ecs.GetResource[components.Collection](w)
+	if err != nil {
+		return
+	}
+	progress.Complete(level.Name, collection.Total, collection.Found)
+	m.keepLives(w)
+
+	if err := progress.Save(); err != nil {
+		fmt.Println("save:", err)
+	}
+
+	ghost.Keep(w, level.Name)
+}
+
+func (m *Manager) load(index int) *ecs.World {
+	level := LevelSequence[index]
+	w := level.Load(m.profile)
+
+	if level.FixedLives > 0 {
+		setLives(w, level.FixedLives)
+	} else {
+		setLives(w, m.lives)
+	}
+
+	var owned []string
+	if progress, ok := save.Current().Levels[level.Name]; ok {
+		owned = progress.Collected
+	}
+	systems.PrepareCollection(w, level.Name, owned)
+	if err := systems.CheckEnemies(w); err != nil {
+		fmt.Println("enemy:", level.Name+":", err)
+	}
+	ghost.Prepare(w, level.Name)
+	if game.IsEchoesOn() {
+		w.SetResource(components.Echoes{})
+	}
+	return w
+}
+
+func (m *Manager) unlocked(index int) bool {
+	if !LevelSequence[index].Secret {
+		return true
+	}
+
+	var names []string
+	for _, level := range LevelSequence[:index] {
+		if !level.Secret {
+			names = append(names, level.Name)
+		}
+	}
+	return save.Current().AllCollected(names...)
+}
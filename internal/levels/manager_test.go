@@ -0,0 +1,199 @@
+package levels
+
+import (
+	"testing"
+
+	"github.com/game-jam-2026/dead-jump/internal/ecs"
+	"github.com/game-jam-2026/dead-jump/internal/game"
+	"github.com/game-jam-2026/dead-jump/internal/save"
+)
+
+// useSequence swaps the level sequence for stub levels and starts from empty
+// progress on the given difficulty.
+func useSequence(t *testing.T, d game.Difficulty, sequence ...Level) {
+	t.Helper()
+
+	oldSequence, oldDifficulty := LevelSequence, game.GetDifficulty()
+	oldProgress := save.Current().Levels
+	t.Cleanup(func() {
+		LevelSequence = oldSequence
+		game.SetDifficulty(oldDifficulty)
+		save.Current().Levels = oldProgress
+	})
+
+	LevelSequence = sequence
+	game.SetDifficulty(d)
+	save.Current().Levels = make(map[string]*save.LevelProgress)
+}
+
+func stubLevel(name string) Level {
+	return Level{Name: name, Load: func(game.Profile) *ecs.World {
+		return ecs.NewWorld()
+	}}
+}
+
+func mustLives(t *testing.T, w *ecs.World) int {
+	t.Helper()
+	if w == nil {
+		t.Fatal("no level loaded")
+	}
+	lives, ok := livesIn(w)
+	if !ok {
+		t.Fatal("level has no life counter")
+	}
+	return lives
+}
+
+func TestNextLevelCarriesLives(t *testing.T) {
+	tests := []struct {
+		name       string
+		difficulty game.Difficulty
+		left       int
+		want       int
+	}{
+		{"normal bonus", game.DifficultyNormal, 4, 5},
+		{"easy bonus", game.DifficultyEasy, 4, 6},
+		{"hard has no bonus", game.DifficultyHard, 4, 4},
+		{"normal cap", game.DifficultyNormal, 15, 15},
+		{"easy cap", game.DifficultyEasy, 19, 20},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			useSequence(t, tt.difficulty, stubLevel("a"), stubLevel("b"))
+
+			m := NewManager()
+			w := m.StartGame()
+			if got, want := mustLives(t, w), game.ProfileFor(tt.difficulty).Lives; got != want {
+				t.Fatalf("first level lives = %d, want %d", got, want)
+			}
+
+			setLives(w, tt.left)
+			m.keepLives(w)
+			if got := mustLives(t, m.NextLevel()); got != tt.want {
+				t.Errorf("next level lives = %d, want %d", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRestartLevelKeepsLives(t *testing.T) {
+	tests := []struct {
+		name string
+		left int
+		want int
+	}{
+		{"lives left", 3, 3},
+		{"above the cap", 40, 15},
+		// a game over world has none to keep, the level starts with the
+		// lives it was entered with
+		{"game over", 0, 8},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			useSequence(t, game.DifficultyNormal, stubLevel("a"))
+
+			m := NewManager()
+			w := m.StartGame()
+			setLives(w, tt.left)
+			if got := mustLives(t, m.RestartLevel(w)); got != tt.want {
+				t.Errorf("restarted lives = %d, want %d", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestFixedLivesLevel(t *testing.T) {
+	fixed := stubLevel("fixed")
+	fixed.FixedLives = 1
+	useSequence(t, game.DifficultyNormal, stubLevel("a"), fixed, stubLevel("b"))
+
+	m := NewManager()
+	w := m.StartGame()
+	setLives(w, 6)
+	m.keepLives(w)
+
+	w = m.NextLevel()
+	if got := mustLives(t, w); got != 1 {
+		t.Fatalf("fixed level lives = %d, want 1", got)
+	}
+	// the fixed level neither spends nor refills the run's lives
+	m.keepLives(w)
+	if got := mustLives(t, m.NextLevel()); got != 8 {
+		t.Errorf("lives after the fixed level = %d, want 8", got)
+	}
+}
+
+func TestContinues(t *testing.T) {
+	for _, d := range []game.Difficulty{game.DifficultyEasy, game.DifficultyNormal, game.DifficultyHard} {
+		t.Run(d.String(), func(t *testing.T) {
+			useSequence(t, d, stubLevel("a"))
+			p := game.ProfileFor(d)
+
+			m := NewManager()
+			setLives(m.StartGame(), 0)
+			for i := p.Continues; i > 0; i-- {
+				if got := m.Continues(); got != i {
+					t.Fatalf("continues = %d, want %d", got, i)
+				}
+				if got := mustLives(t, m.Continue()); got != p.Lives {
+					t.Fatalf("lives after continue = %d, want %d", got, p.Lives)
+				}
+			}
+
+			if m.Continues() != 0 {
+				t.Fatalf("continues = %d after spending all", m.Continues())
+			}
+			if m.Continue() != nil {
+				t.Error("continued without continues left")
+			}
+		})
+	}
+}
+
+func TestSecretLevelUnlock(t *testing.T) {
+	secret := stubLevel("secret")
+	secret.Secret = true
+
+	complete := func(name string, found ...string) func(*save.Progress) {
+		return func(p *save.Progress) { p.Complete(name, 2, found) }
+	}
+
+	tests := []struct {
+		name     string
+		progress []func(*save.Progress)
+		want     bool
+	}{
+		{"no progress", nil, false},
+		{"missing a collectible", []func(*save.Progress){
+			complete("a", "1", "2"), complete("b", "1"),
+		}, false},
+		{"level not finished", []func(*save.Progress){
+			complete("b", "1", "2"),
+		}, false},
+		{"everything collected", []func(*save.Progress){
+			complete("a", "1", "2"), complete("b", "1", "2"),
+		}, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			useSequence(t, game.DifficultyNormal, stubLevel("a"), stubLevel("b"), secret)
+			for _, apply := range tt.progress {
+				apply(save.Current())
+			}
+
+			m := NewManager()
+			if _, err := m.JumpTo(1); err != nil {
+				t.Fatal(err)
+			}
+			if got := m.HasNextLevel(); got != tt.want {
+				t.Errorf("HasNextLevel() = %v, want %v", got, tt.want)
+			}
+			if got := m.NextLevel() != nil; got != tt.want {
+				t.Errorf("NextLevel() loaded = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
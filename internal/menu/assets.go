@@ -2,12 +2,15 @@ package menu
 
 import (
 	"bytes"
-	_ "embed"
-	"image"
-	_ "image/png"
+	"embed"
+	"fmt"
+	"path/filepath"
+	"runtime"
 
 	"github.com/hajimehoshi/ebiten/v2"
 	"github.com/hajimehoshi/ebiten/v2/text/v2"
+
+	"github.com/game-jam-2026/dead-jump/internal/assets/registry"
 )
 
 //go:embed assets/fonts/PressStart2P-Regular.ttf
@@ -17,33 +20,16 @@ func FontData() []byte {
 	return fontData
 }
 
-//go:embed assets/img/skull.png
-var skullPNG []byte
-
-//go:embed assets/img/rotten_apple.png
-var rottenApplePNG []byte
-
-//go:embed assets/img/dead_orange.png
-var deadOrangePNG []byte
-
-//go:embed assets/img/withered_cherry.png
-var witheredCherryPNG []byte
-
-//go:embed assets/img/rotted_banana.png
-var rottedBananaPNG []byte
+//go:embed assets/img/*.png
+var imageFS embed.FS
 
-//go:embed assets/img/title_dead.png
-var titleDeadPNG []byte
+var images = registry.New(imageFS, "assets/img")
 
-//go:embed assets/img/title_jump.png
-var titleJumpPNG []byte
-
-func loadImage(data []byte) *ebiten.Image {
-	img, _, err := image.Decode(bytes.NewReader(data))
-	if err != nil {
-		panic(err)
+func init() {
+	_, file, _, ok := runtime.Caller(0)
+	if ok {
+		images.Watch(filepath.Join(filepath.Dir(file), "assets", "img"))
 	}
-	return ebiten.NewImageFromImage(img)
 }
 
 func (m *Menu) loadAssets() {
@@ -55,14 +41,18 @@ func (m *Menu) loadAssets() {
 	m.fontSmall = &text.GoTextFace{Source: source, Size: FontSizeSmall}
 	m.fontMedium = &text.GoTextFace{Source: source, Size: FontSizeMedium}
 
-	m.titleDeadImg = loadImage(titleDeadPNG)
-	m.titleJumpImg = loadImage(titleJumpPNG)
-	m.skullImg = loadImage(skullPNG)
+	if err := images.LoadAll(); err != nil {
+		fmt.Println(err)
+	}
+
+	m.titleDeadImg = images.Image("title_dead")
+	m.titleJumpImg = images.Image("title_jump")
+	m.skullImg = images.Image("skull")
 
 	m.objectImages = []*ebiten.Image{
-		loadImage(rottenApplePNG),
-		loadImage(deadOrangePNG),
-		loadImage(witheredCherryPNG),
-		loadImage(rottedBananaPNG),
+		images.Image("rotten_apple"),
+		images.Image("dead_orange"),
+		images.Image("withered_cherry"),
+		images.Image("rotted_banana"),
 	}
 }
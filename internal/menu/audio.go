@@ -56,7 +56,7 @@ func (m *Menu) shouldPlayMenuMusic() bool {
 	switch m.state {
 	case StateMenu:
 		return true
-	case StateSettings:
+	case StateSettings, StateAssist:
 		return m.previousState == StateMenu
 	default:
 		return false
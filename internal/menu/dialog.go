@@ -5,7 +5,10 @@ import (
 	"github.com/hajimehoshi/ebiten/v2/inpututil"
 )
 
-func (m *Menu) ShowLevelComplete() {
+// ShowLevelComplete shows the level complete screen; without a next level
+// it leads to the credits instead.
+func (m *Menu) ShowLevelComplete(hasNext bool) {
+	m.initLevelCompleteItems(hasNext)
 	m.state = StateLevelComplete
 	m.selectedIndex = 0
 	audio.RestartMusic(audio.SoundVictory)
@@ -14,7 +17,10 @@ func (m *Menu) ShowLevelComplete() {
 	}
 }
 
-func (m *Menu) ShowGameOver() {
+// ShowGameOver shows the game over screen with the continues the run has
+// left.
+func (m *Menu) ShowGameOver(continues int) {
+	m.initGameOverItems(continues)
 	m.state = StateGameOver
 	m.selectedIndex = 0
 	audio.RestartMusic(audio.SoundGameOver)
@@ -29,6 +35,13 @@ func (m *Menu) ShowEpilogueEnding() {
 	m.epilogueTimer = 0
 }
 
+// ShowCredits ends the run when there is no level left to play.
+func (m *Menu) ShowCredits() {
+	m.state = StateCredits
+	m.selectedIndex = 0
+	m.creditsTimer = 0
+}
+
 func (m *Menu) ShowDialog(title, message string, buttons []MenuItem) {
 	m.activeDialog = &Dialog{
 		Title:    title,
@@ -7,6 +7,8 @@ import (
 
 	"github.com/hajimehoshi/ebiten/v2"
 	"github.com/hajimehoshi/ebiten/v2/text/v2"
+
+	"github.com/game-jam-2026/dead-jump/internal/game"
 )
 
 func (m *Menu) Draw(screen *ebiten.Image) {
@@ -17,9 +19,14 @@ func (m *Menu) Draw(screen *ebiten.Image) {
 		return
 	}
 
+	if m.state == StateCredits {
+		m.drawCreditsScreen(screen, shakeX, shakeY)
+		return
+	}
+
 	isInGameOverlay := m.state == StateLevelComplete || m.state == StateGameOver ||
 		m.state == StatePaused || m.state == StateConfirmRestart ||
-		(m.state == StateSettings && m.previousState == StatePaused)
+		((m.state == StateSettings || m.state == StateAssist) && m.previousState == StatePaused)
 
 	if isInGameOverlay {
 		m.drawDarkOverlay(screen)
@@ -30,7 +37,11 @@ func (m *Menu) Draw(screen *ebiten.Image) {
 		return
 	}
 
-	screen.Fill(colorBgDark)
+	if m.overWorld {
+		m.drawDarkOverlay(screen)
+	} else {
+		screen.Fill(colorBgDark)
+	}
 
 	m.drawFallingObjects(screen, shakeX, shakeY)
 	m.drawTitle(screen, shakeX, shakeY)
@@ -97,19 +108,38 @@ func (m *Menu) drawStateContent(screen *ebiten.Image, shakeX, shakeY float64) {
 		m.drawGameOverScreen(screen, shakeX, shakeY)
 	case StateDifficultySelect:
 		m.drawDifficultySelectScreen(screen, shakeX, shakeY)
+	case StateAssist:
+		m.drawDarkOverlay(screen)
+		m.drawAssistMenu(screen, shakeX, shakeY)
 	case StatePlaying:
 	}
 }
 
 func (m *Menu) drawDifficultySelectScreen(screen *ebiten.Image, shakeX, shakeY float64) {
 	centerX := float64(ScreenWidth) / 2
-	m.drawText(screen, "SELECT DIFFICULTY", centerX+shakeX, 90+shakeY, m.fontMedium, colorBloodRed, true)
-	m.drawMenuItems(screen, m.difficultyItems, 120, shakeX, shakeY)
+	m.drawText(screen, "SELECT DIFFICULTY", centerX+shakeX, 70+shakeY, m.fontMedium, colorBloodRed, true)
+	m.drawMenuItems(screen, m.difficultyItems, 100, shakeX, shakeY)
+
+	// Что меняет выбранный пресет
+	if m.selectedIndex >= len(difficultyPresets) {
+		return
+	}
+	changes := game.ProfileFor(difficultyPresets[m.selectedIndex]).Changes()
+	for i, line := range changes {
+		y := 176 + float64(i)*10 + shakeY
+		m.drawText(screen, line, centerX+shakeX, y, m.fontSmall, colorDimGray, true)
+	}
+}
+
+func (m *Menu) drawAssistMenu(screen *ebiten.Image, shakeX, shakeY float64) {
+	centerX := float64(ScreenWidth) / 2
+	m.drawText(screen, "ASSIST", centerX+shakeX, 85+shakeY, m.fontMedium, colorBloodRed, true)
+	m.drawMenuItems(screen, m.assistItems, 110, shakeX, shakeY)
 }
 
 func (m *Menu) drawHint(screen *ebiten.Image, shakeY float64) {
 	centerX := float64(ScreenWidth) / 2
-	hintY := float64(ScreenHeight) - 22 + shakeY
+	hintY := float64(ScreenHeight) - 14 + shakeY
 	var hint string
 	if m.state == StateSettings && m.selectedIndex < 3 {
 		hint = "< > VOLUME  ESC BACK"
@@ -358,7 +388,7 @@ func (m *Menu) drawSettingsMenu(screen *ebiten.Image, shakeX, shakeY float64) {
 
 	m.drawText(screen, "SETTINGS", centerX+shakeX, 85+shakeY, m.fontMedium, colorBloodRed, true)
 
-	startY := 110.0
+	startY := 104.0
 	volumeItemCount := 3
 	for i, item := range m.settingsItems {
 		y := startY + float64(i)*SettingsItemSpacing + shakeY
@@ -391,6 +421,20 @@ func (m *Menu) drawGameOverScreen(screen *ebiten.Image, shakeX, shakeY float64)
 	m.drawMenuItems(screen, m.gameOverItems, 120, shakeX, shakeY)
 }
 
+func (m *Menu) drawCreditsScreen(screen *ebiten.Image, shakeX, shakeY float64) {
+	screen.Fill(color.RGBA{0, 0, 0, 255})
+
+	centerX := float64(ScreenWidth) / 2
+	centerY := float64(ScreenHeight) / 2
+
+	m.drawText(screen, "THE END", centerX+shakeX, centerY-20+shakeY, m.fontMedium, colorGhostWhite, true)
+	m.drawText(screen, "...some souls were left behind.", centerX+shakeX, centerY+10+shakeY, m.fontSmall, colorDimGray, true)
+
+	if m.creditsTimer > 60 {
+		m.drawText(screen, "Press ENTER to continue", centerX+shakeX, centerY+60+shakeY, m.fontSmall, colorDeadPurple, true)
+	}
+}
+
 func (m *Menu) drawEpilogueEndingScreen(screen *ebiten.Image, shakeX, shakeY float64) {
 	screen.Fill(color.RGBA{0, 0, 0, 255})
 
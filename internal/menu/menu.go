@@ -1,8 +1,11 @@
 package menu
 
 import (
+	"fmt"
 	"math"
 	"math/rand"
+	"slices"
+	"strings"
 
 	"github.com/game-jam-2026/dead-jump/internal/game"
 	"github.com/game-jam-2026/dead-jump/internal/utils/audio"
@@ -37,10 +40,11 @@ func NewMenu() *Menu {
 	m.initPauseItems()
 	m.initConfirmItems()
 	m.initSettingsItems()
-	m.initLevelCompleteItems()
-	m.initGameOverItems()
+	m.initLevelCompleteItems(true)
+	m.initGameOverItems(0)
 	m.initEpilogueItems()
 	m.initDifficultyItems()
+	m.initAssistItems()
 
 	m.loadAssets()
 	m.spawnInitialObjects()
@@ -53,7 +57,7 @@ func (m *Menu) initMenuItems() {
 	m.items = []MenuItem{
 		{Text: "START", Action: func() {
 			m.state = StateDifficultySelect
-			m.selectedIndex = 0
+			m.selectedIndex = int(game.GetDifficulty())
 		}},
 		{Text: "SETTINGS", Action: func() {
 			m.previousState = StateMenu
@@ -111,21 +115,31 @@ func (m *Menu) initConfirmItems() {
 	}
 }
 
-func (m *Menu) initLevelCompleteItems() {
-	m.levelCompleteItems = []MenuItem{
-		{Text: "NEXT LEVEL", Action: func() {
+// initLevelCompleteItems offers the next level while there is one;
+// otherwise the run ends with the credits.
+func (m *Menu) initLevelCompleteItems(hasNext bool) {
+	next := MenuItem{Text: "FINISH", Action: func() {
+		audio.StopMusic(audio.SoundVictory)
+		m.ShowCredits()
+	}}
+	if hasNext {
+		next = MenuItem{Text: "NEXT LEVEL", Action: func() {
 			audio.StopMusic(audio.SoundVictory)
 			if m.OnNextLevel != nil {
 				m.OnNextLevel()
 			}
-			m.SetState(StatePlaying)
-		}},
+			m.playIfStill(StateLevelComplete)
+		}}
+	}
+
+	m.levelCompleteItems = []MenuItem{
+		next,
 		{Text: "RESTART", Action: func() {
 			audio.StopMusic(audio.SoundVictory)
 			if m.OnRestart != nil {
 				m.OnRestart()
 			}
-			m.SetState(StatePlaying)
+			m.playIfStill(StateLevelComplete)
 		}},
 		{Text: "MAIN MENU", Action: func() {
 			audio.StopMusic(audio.SoundVictory)
@@ -138,15 +152,28 @@ func (m *Menu) initLevelCompleteItems() {
 	}
 }
 
-func (m *Menu) initGameOverItems() {
-	m.gameOverItems = []MenuItem{
-		{Text: "TRY AGAIN", Action: func() {
+// initGameOverItems offers a continue while the run has some left;
+// otherwise the run starts over.
+func (m *Menu) initGameOverItems(continues int) {
+	retry := MenuItem{Text: "NEW RUN", Action: func() {
+		audio.StopMusic(audio.SoundGameOver)
+		if m.OnStartGame != nil {
+			m.OnStartGame()
+		}
+		m.SetState(StatePlaying)
+	}}
+	if continues > 0 {
+		retry = MenuItem{Text: fmt.Sprintf("CONTINUE (%d)", continues), Action: func() {
 			audio.StopMusic(audio.SoundGameOver)
-			if m.OnRestart != nil {
-				m.OnRestart()
+			if m.OnContinue != nil {
+				m.OnContinue()
 			}
 			m.SetState(StatePlaying)
-		}},
+		}}
+	}
+
+	m.gameOverItems = []MenuItem{
+		retry,
 		{Text: "MAIN MENU", Action: func() {
 			audio.StopMusic(audio.SoundGameOver)
 			if m.OnMainMenu != nil {
@@ -158,6 +185,14 @@ func (m *Menu) initGameOverItems() {
 	}
 }
 
+// playIfStill goes back to the game unless a callback has already moved the
+// menu away from state.
+func (m *Menu) playIfStill(state GameState) {
+	if m.state == state {
+		m.SetState(StatePlaying)
+	}
+}
+
 func (m *Menu) initEpilogueItems() {
 	m.epilogueItems = []MenuItem{
 		{Text: "CONTINUE", Action: func() {
@@ -169,32 +204,116 @@ func (m *Menu) initEpilogueItems() {
 	}
 }
 
+// assistSettingsIndex is where the ASSIST item sits in the settings.
+const assistSettingsIndex = 7
+
+// difficultyPresets are the presets in the order of the difficulty items.
+var difficultyPresets = []game.Difficulty{game.DifficultyEasy, game.DifficultyNormal, game.DifficultyHard}
+
 func (m *Menu) initDifficultyItems() {
-	m.difficultyItems = []MenuItem{
-		{Text: "NORMAL", Action: func() {
-			game.SetDifficulty(game.DifficultyEasy)
-			if m.OnStartGame != nil {
-				m.OnStartGame()
-			}
+	m.difficultyItems = nil
+	for _, d := range difficultyPresets {
+		m.difficultyItems = append(m.difficultyItems, MenuItem{
+			Text: strings.ToUpper(d.String()),
+			Action: func() {
+				game.SetDifficulty(d)
+				if m.OnStartGame != nil {
+					m.OnStartGame()
+				}
+			},
+		})
+	}
+	m.difficultyItems = append(m.difficultyItems, MenuItem{Text: "BACK", Action: func() {
+		m.state = StateMenu
+		m.selectedIndex = 0
+	}})
+}
+
+func (m *Menu) initAssistItems() {
+	m.assistItems = []MenuItem{
+		{Text: m.getGameSpeedText(), Action: func() {
+			a := game.CurrentAssists()
+			i := (slices.Index(game.GameSpeeds, a.GameSpeed) + 1) % len(game.GameSpeeds)
+			a.GameSpeed = game.GameSpeeds[i]
+			game.SetAssists(a)
+			m.updateAssistItems()
 		}},
-		{Text: "HARD", Action: func() {
-			game.SetDifficulty(game.DifficultyHard)
-			if m.OnStartGame != nil {
-				m.OnStartGame()
-			}
+		{Text: m.getInvincibleText(), Action: func() {
+			a := game.CurrentAssists()
+			a.Invincible = !a.Invincible
+			game.SetAssists(a)
+			m.updateAssistItems()
+		}},
+		{Text: m.getInfiniteLivesText(), Action: func() {
+			a := game.CurrentAssists()
+			a.InfiniteLives = !a.InfiniteLives
+			game.SetAssists(a)
+			m.updateAssistItems()
+		}},
+		{Text: m.getAirControlText(), Action: func() {
+			a := game.CurrentAssists()
+			a.AirControl = !a.AirControl
+			game.SetAssists(a)
+			m.updateAssistItems()
 		}},
 		{Text: "BACK", Action: func() {
-			m.state = StateMenu
-			m.selectedIndex = 0
+			m.state = StateSettings
+			m.selectedIndex = assistSettingsIndex
 		}},
 	}
 }
 
+func (m *Menu) getGameSpeedText() string {
+	return fmt.Sprintf("SPEED:       %d%%", int(game.CurrentAssists().GameSpeed*100+0.5))
+}
+
+func (m *Menu) getInvincibleText() string {
+	return "INVINCIBLE:  " + onOff(game.CurrentAssists().Invincible)
+}
+
+func (m *Menu) getInfiniteLivesText() string {
+	return "INF LIVES:   " + onOff(game.CurrentAssists().InfiniteLives)
+}
+
+func (m *Menu) getAirControlText() string {
+	return "AIR CONTROL: " + onOff(game.CurrentAssists().AirControl)
+}
+
+func (m *Menu) updateAssistItems() {
+	if len(m.assistItems) >= 4 {
+		m.assistItems[0].Text = m.getGameSpeedText()
+		m.assistItems[1].Text = m.getInvincibleText()
+		m.assistItems[2].Text = m.getInfiniteLivesText()
+		m.assistItems[3].Text = m.getAirControlText()
+	}
+}
+
 func (m *Menu) initSettingsItems() {
 	m.settingsItems = []MenuItem{
 		{Text: m.getMasterVolumeText(), Action: func() {}},
 		{Text: m.getMusicVolumeText(), Action: func() {}},
 		{Text: m.getSFXVolumeText(), Action: func() {}},
+		{Text: m.getTimerText(), Action: func() {
+			game.SetSpeedrunTimer(!game.IsSpeedrunTimerOn())
+			m.updateSettingsItems()
+		}},
+		{Text: m.getMenuTimeText(), Action: func() {
+			game.SetCountMenuTime(!game.CountsMenuTime())
+			m.updateSettingsItems()
+		}},
+		{Text: m.getEchoesText(), Action: func() {
+			game.SetEchoes(!game.IsEchoesOn())
+			m.updateSettingsItems()
+		}},
+		{Text: "EXPORT SPLITS", Action: func() {
+			if m.OnExportSplits != nil {
+				m.OnExportSplits()
+			}
+		}},
+		{Text: "ASSIST", Action: func() {
+			m.state = StateAssist
+			m.selectedIndex = 0
+		}},
 		{Text: "BACK", Action: func() {
 			m.state = m.previousState
 			m.selectedIndex = 0
@@ -217,6 +336,25 @@ func (m *Menu) getSFXVolumeText() string {
 	return "SFX:     " + m.volumeBar(vol)
 }
 
+func (m *Menu) getTimerText() string {
+	return "TIMER:     " + onOff(game.IsSpeedrunTimerOn())
+}
+
+func (m *Menu) getMenuTimeText() string {
+	return "MENU TIME: " + onOff(game.CountsMenuTime())
+}
+
+func (m *Menu) getEchoesText() string {
+	return "ECHOES:    " + onOff(game.IsEchoesOn())
+}
+
+func onOff(on bool) string {
+	if on {
+		return "ON"
+	}
+	return "OFF"
+}
+
 func (m *Menu) volumeBar(level int) string {
 	bar := "<"
 	for i := 0; i < VolumeSteps; i++ {
@@ -236,6 +374,11 @@ func (m *Menu) updateSettingsItems() {
 		m.settingsItems[1].Text = m.getMusicVolumeText()
 		m.settingsItems[2].Text = m.getSFXVolumeText()
 	}
+	if len(m.settingsItems) >= 6 {
+		m.settingsItems[3].Text = m.getTimerText()
+		m.settingsItems[4].Text = m.getMenuTimeText()
+		m.settingsItems[5].Text = m.getEchoesText()
+	}
 }
 
 func (m *Menu) adjustVolume(delta float64) {
@@ -251,6 +394,12 @@ func (m *Menu) adjustVolume(delta float64) {
 	m.updateMusicVolume()
 }
 
+// SetOverWorld makes the title screen translucent so a world drawn before it
+// shows through.
+func (m *Menu) SetOverWorld(over bool) {
+	m.overWorld = over
+}
+
 func (m *Menu) GetState() GameState {
 	return m.state
 }
@@ -285,6 +434,11 @@ func (m *Menu) Update() {
 		return
 	}
 
+	if m.state == StateCredits {
+		m.updateCredits()
+		return
+	}
+
 	items := m.getActiveItems()
 	if items == nil {
 		return
@@ -309,6 +463,21 @@ func (m *Menu) updateEpilogue() {
 	}
 }
 
+func (m *Menu) updateCredits() {
+	m.creditsTimer++
+
+	if m.creditsTimer > 60 {
+		if inpututil.IsKeyJustPressed(keyEnter) || inpututil.IsKeyJustPressed(keySpace) {
+			m.playConfirmSound()
+			if m.OnMainMenu != nil {
+				m.OnMainMenu()
+			}
+			m.state = StateMenu
+			m.selectedIndex = 0
+		}
+	}
+}
+
 func (m *Menu) updateScreenShake() {
 	if m.screenShake > 0 {
 		m.screenShake *= 0.9
@@ -379,6 +548,8 @@ func (m *Menu) getActiveItems() []MenuItem {
 		return m.epilogueItems
 	case StateDifficultySelect:
 		return m.difficultyItems
+	case StateAssist:
+		return m.assistItems
 	case StatePlaying:
 		return nil
 	default:
@@ -445,5 +616,9 @@ func (m *Menu) handleEscapeKey() {
 		m.playSelectSound()
 		m.state = StateMenu
 		m.selectedIndex = 0
+	case StateAssist:
+		m.playSelectSound()
+		m.state = StateSettings
+		m.selectedIndex = assistSettingsIndex
 	}
 }
@@ -26,7 +26,7 @@ const (
 const (
 	TitleSpacing        = 8.0  // Pixels between title images
 	MenuItemSpacing     = 20.0 // Pixels between menu items
-	SettingsItemSpacing = 18.0 // Pixels between settings items
+	SettingsItemSpacing = 14.0 // Pixels between settings items
 	SkullMargin         = 18.0 // Distance from text to skull indicators
 	CharWidthPixels     = 8.0  // Width of one character in pixels
 )
@@ -56,6 +56,8 @@ const (
 	StateGameOver
 	StateEpilogueEnding
 	StateDifficultySelect
+	StateAssist
+	StateCredits
 )
 
 type SubtitlePhase int
@@ -149,11 +151,17 @@ type Menu struct {
 
 	epilogueItems   []MenuItem
 	epilogueTimer   int
+	creditsTimer    int
 	difficultyItems []MenuItem
+	assistItems     []MenuItem
+
+	// overWorld draws the title screen translucent over a running demo
+	overWorld bool
 
 	// Callbacks
 	OnStartGame        func()
 	OnRestart          func()
+	OnContinue         func()
 	OnQuit             func()
 	OnResume           func()
 	OnNextLevel        func()
@@ -161,6 +169,7 @@ type Menu struct {
 	OnGameOver         func()
 	OnMainMenu         func()
 	OnEpilogueComplete func()
+	OnExportSplits     func()
 }
 
 var (
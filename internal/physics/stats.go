@@ -0,0 +1,8 @@
+package physics
+
+// Stats describes the last physics tick. It is stored as a world resource
+// by ApplyVelocityWithCollisions.
+type Stats struct {
+	Substeps   int
+	Collisions int
+}
@@ -0,0 +1,22 @@
+package save
+
+import "encoding/json"
+
+// Load decodes the stored value called name into v; a missing value leaves v
+// untouched.
+func Load(name string, v any) error {
+	data, err := readStorage(name)
+	if err != nil || len(data) == 0 {
+		return err
+	}
+	return json.Unmarshal(data, v)
+}
+
+// Store encodes v and keeps it under name.
+func Store(name string, v any) error {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+	return writeStorage(name, data)
+}
@@ -0,0 +1,87 @@
+// Package save keeps player progress between sessions: a JSON file on
+// desktop, localStorage in the browser.
+package save
+
+import (
+	"fmt"
+	"slices"
+)
+
+const progressName = "progress"
+
+type LevelProgress struct {
+	Completed bool     `json:"completed"`
+	Collected []string `json:"collected,omitempty"`
+	Total     int      `json:"total"`
+}
+
+func (l *LevelProgress) AllCollected() bool {
+	return len(l.Collected) >= l.Total
+}
+
+type Progress struct {
+	Levels map[string]*LevelProgress `json:"levels"`
+}
+
+var current *Progress
+
+// Current returns the progress loaded on first use; a missing or broken save
+// starts from scratch.
+func Current() *Progress {
+	if current != nil {
+		return current
+	}
+
+	current = &Progress{Levels: make(map[string]*LevelProgress)}
+
+	if err := Load(progressName, current); err != nil {
+		fmt.Println("save: ignoring broken progress:", err)
+		current = &Progress{Levels: make(map[string]*LevelProgress)}
+	}
+	if current.Levels == nil {
+		current.Levels = make(map[string]*LevelProgress)
+	}
+	return current
+}
+
+func (p *Progress) Level(name string) *LevelProgress {
+	level, ok := p.Levels[name]
+	if !ok {
+		level = &LevelProgress{}
+		p.Levels[name] = level
+	}
+	return level
+}
+
+func (p *Progress) IsCollected(level, id string) bool {
+	l, ok := p.Levels[level]
+	return ok && slices.Contains(l.Collected, id)
+}
+
+// Complete records a finished level and the collectibles found in it.
+func (p *Progress) Complete(level string, total int, found []string) {
+	l := p.Level(level)
+	l.Completed = true
+	l.Total = total
+	for _, id := range found {
+		if !slices.Contains(l.Collected, id) {
+			l.Collected = append(l.Collected, id)
+		}
+	}
+}
+
+// AllCollected reports whether every listed level is completed with all of
+// its collectibles found.
+func (p *Progress) AllCollected(levels ...string) bool {
+	for _, name := range levels {
+		l, ok := p.Levels[name]
+		if !ok || !l.Completed || !l.AllCollected() {
+			return false
+		}
+	}
+	return true
+}
+
+func (p *Progress) Save() error {
+	return Store(progressName, p)
+}
@@ -0,0 +1,46 @@
+//go:build !js
+
+package save
+
+import (
+	"errors"
+	"io/fs"
+	"os"
+	"path/filepath"
+)
+
+func storageDir() string {
+	dir, err := os.UserConfigDir()
+	if err != nil {
+		return "."
+	}
+	return filepath.Join(dir, "dead-jump")
+}
+
+func readStorage(name string) ([]byte, error) {
+	data, err := os.ReadFile(filepath.Join(storageDir(), name+".json"))
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil, nil
+	}
+	return data, err
+}
+
+func writeStorage(name string, data []byte) error {
+	_, err := writeFile(name+".json", data)
+	return err
+}
+
+// Export writes a file for the player to take elsewhere and returns where it
+// went.
+func Export(fileName string, data []byte) (string, error) {
+	return writeFile(fileName, data)
+}
+
+func writeFile(fileName string, data []byte) (string, error) {
+	dir := storageDir()
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return "", err
+	}
+	path := filepath.Join(dir, fileName)
+	return path, os.WriteFile(path, data, 0o644)
+}
@@ -0,0 +1,62 @@
+//go:build js
+
+package save
+
+import (
+	"errors"
+	"syscall/js"
+)
+
+const keyPrefix = "dead-jump-"
+
+func localStorage() (js.Value, error) {
+	storage := js.Global().Get("localStorage")
+	if storage.IsUndefined() || storage.IsNull() {
+		return js.Value{}, errors.New("localStorage is not available")
+	}
+	return storage, nil
+}
+
+func readStorage(name string) ([]byte, error) {
+	storage, err := localStorage()
+	if err != nil {
+		return nil, err
+	}
+
+	item := storage.Call("getItem", keyPrefix+name)
+	if item.IsNull() {
+		return nil, nil
+	}
+	return []byte(item.String()), nil
+}
+
+func writeStorage(name string, data []byte) error {
+	storage, err := localStorage()
+	if err != nil {
+		return err
+	}
+
+	storage.Call("setItem", keyPrefix+name, string(data))
+	return nil
+}
+
+// Export offers the file as a browser download.
+func Export(fileName string, data []byte) (string, error) {
+	document := js.Global().Get("document")
+	if document.IsUndefined() {
+		return "", errors.New("no document to download from")
+	}
+
+	bytes := js.Global().Get("Uint8Array").New(len(data))
+	js.CopyBytesToJS(bytes, data)
+	blob := js.Global().Get("Blob").New([]any{bytes})
+	url := js.Global().Get("URL").Call("createObjectURL", blob)
+
+	link := document.Call("createElement", "a")
+	link.Set("href", url)
+	link.Set("download", fileName)
+	link.Call("click")
+	js.Global().Get("URL").Call("revokeObjectURL", url)
+
+	return fileName, nil
+}
@@ -0,0 +1,152 @@
+package snapshot
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"image"
+	"image/png"
+	"reflect"
+	"strings"
+
+	"github.com/hajimehoshi/ebiten/v2"
+
+	"github.com/game-jam-2026/dead-jump/internal/assets"
+)
+
+type codec struct {
+	name   string
+	encode func(e *Encoder, v interface{}) (interface{}, error)
+	decode func(d *Decoder, data json.RawMessage) (interface{}, error)
+}
+
+var (
+	codecsByName = make(map[string]*codec)
+	codecsByType = make(map[reflect.Type]*codec)
+)
+
+// Register adds a codec for component or resource type C. The value is
+// converted to D, which must be JSON-encodable, and back on restore.
+func Register[C, D any](
+	name string,
+	encode func(e *Encoder, c C) (D, error),
+	decode func(d *Decoder, data D) (C, error),
+) {
+	t := reflect.TypeOf((*C)(nil)).Elem()
+	if _, ok := codecsByName[name]; ok {
+		panic(fmt.Sprintf("snapshot: codec %q registered twice", name))
+	}
+
+	c := &codec{
+		name: name,
+		encode: func(e *Encoder, v interface{}) (interface{}, error) {
+			return encode(e, v.(C))
+		},
+		decode: func(d *Decoder, data json.RawMessage) (interface{}, error) {
+			var dto D
+			if err := json.Unmarshal(data, &dto); err != nil {
+				return nil, err
+			}
+			return decode(d, dto)
+		},
+	}
+
+	codecsByName[name] = c
+	codecsByType[t] = c
+}
+
+// RegisterValue adds a codec for types that are plain data and can be
+// encoded as they are.
+func RegisterValue[C any](name string) {
+	Register(name,
+		func(_ *Encoder, c C) (C, error) { return c, nil },
+		func(_ *Decoder, c C) (C, error) { return c, nil },
+	)
+}
+
+const (
+	assetRefPrefix = "asset:"
+	pngRefPrefix   = "png:"
+)
+
+// Encoder turns images into references: registry images by asset name,
+// everything else (generated or packed images) as embedded PNGs.
+type Encoder struct {
+	snapshot *Snapshot
+	refs     map[*ebiten.Image]string
+}
+
+func (e *Encoder) ImageRef(img *ebiten.Image) (string, error) {
+	if img == nil {
+		return "", nil
+	}
+	if ref, ok := e.refs[img]; ok {
+		return ref, nil
+	}
+
+	var ref string
+	if name, ok := assets.Images.NameOf(img); ok {
+		ref = assetRefPrefix + name
+	} else {
+		data, err := encodePNG(img)
+		if err != nil {
+			return "", err
+		}
+		ref = fmt.Sprintf("%s%d", pngRefPrefix, len(e.snapshot.Images))
+		e.snapshot.Images[ref] = data
+	}
+
+	e.refs[img] = ref
+	return ref, nil
+}
+
+func encodePNG(img *ebiten.Image) ([]byte, error) {
+	b := img.Bounds()
+	rgba := image.NewRGBA(image.Rect(0, 0, b.Dx(), b.Dy()))
+	img.ReadPixels(rgba.Pix)
+
+	var buf bytes.Buffer
+	if err := png.Encode(&buf, rgba); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+type Decoder struct {
+	snapshot *Snapshot
+	images   map[string]*ebiten.Image
+}
+
+func (d *Decoder) Image(ref string) (*ebiten.Image, error) {
+	if ref == "" {
+		return nil, nil
+	}
+	if img, ok := d.images[ref]; ok {
+		return img, nil
+	}
+
+	var img *ebiten.Image
+	switch {
+	case strings.HasPrefix(ref, assetRefPrefix):
+		var err error
+		img, err = assets.Images.Load(strings.TrimPrefix(ref, assetRefPrefix))
+		if err != nil {
+			return nil, err
+		}
+	case strings.HasPrefix(ref, pngRefPrefix):
+		data, ok := d.snapshot.Images[ref]
+		if !ok {
+			return nil, fmt.Errorf("snapshot: missing image %q", ref)
+		}
+		src, err := png.Decode(bytes.NewReader(data))
+		if err != nil {
+			return nil, fmt.Errorf("snapshot: image %q: %w", ref, err)
+		}
+		img = ebiten.NewImageFromImage(src)
+	default:
+		return nil, fmt.Errorf("snapshot: bad image reference %q", ref)
+	}
+
+	d.images[ref] = img
+	return img, nil
+}
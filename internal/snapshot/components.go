@@ -0,0 +1,241 @@
+package snapshot
+
+import (
+	"fmt"
+
+	"github.com/hajimehoshi/ebiten/v2"
+	"github.com/solarlune/resolv"
+
+	"github.com/game-jam-2026/dead-jump/internal/assets"
+	"github.com/game-jam-2026/dead-jump/internal/ecs/components"
+	"github.com/game-jam-2026/dead-jump/internal/physics"
+	"github.com/game-jam-2026/dead-jump/internal/utils/audio"
+	"github.com/game-jam-2026/dead-jump/pkg/linalg"
+)
+
+func init() {
+	RegisterValue[components.Position]("Position")
+	RegisterValue[components.Velocity]("Velocity")
+	RegisterValue[components.PhysicsBody]("PhysicsBody")
+	RegisterValue[components.Corpse]("Corpse")
+	RegisterValue[components.Cannon]("Cannon")
+	RegisterValue[components.CannonTrigger]("CannonTrigger")
+	RegisterValue[components.Projectile]("Projectile")
+	RegisterValue[components.Character]("Character")
+	RegisterValue[components.Life]("Life")
+	RegisterValue[components.Spike]("Spike")
+	RegisterValue[components.StartPoint]("StartPoint")
+	RegisterValue[components.LevelFinish]("LevelFinish")
+	RegisterValue[components.EpilogueFinish]("EpilogueFinish")
+	RegisterValue[components.Repeatable]("Repeatable")
+	RegisterValue[components.Surface]("Surface")
+	RegisterValue[components.ParallaxLayer]("ParallaxLayer")
+	RegisterValue[components.Invulnerable]("Invulnerable")
+	RegisterValue[components.LoreText]("LoreText")
+	RegisterValue[components.Fade]("Fade")
+	RegisterValue[components.Enemy]("Enemy")
+	RegisterValue[components.Brain]("Brain")
+	RegisterValue[components.Patrol]("Patrol")
+	RegisterValue[components.Walker]("Walker")
+	RegisterValue[components.SineMover]("SineMover")
+	RegisterValue[components.Chaser]("Chaser")
+	RegisterValue[components.Sensor]("Sensor")
+	RegisterValue[components.Hazard]("Hazard")
+	RegisterValue[components.Saw]("Saw")
+	RegisterValue[components.FallingBlock]("FallingBlock")
+	RegisterValue[components.Crusher]("Crusher")
+	RegisterValue[components.PressurePlate]("PressurePlate")
+	RegisterValue[components.Lever]("Lever")
+	RegisterValue[components.TimedButton]("TimedButton")
+	RegisterValue[components.LogicGate]("LogicGate")
+	RegisterValue[components.SignalReceiver]("SignalReceiver")
+	RegisterValue[components.Door]("Door")
+	RegisterValue[components.MovingPlatform]("MovingPlatform")
+	RegisterValue[components.Collectible]("Collectible")
+	RegisterValue[components.HiddenWall]("HiddenWall")
+	RegisterValue[components.Collection]("Collection")
+	RegisterValue[components.LevelStats]("LevelStats")
+	RegisterValue[components.Ghost]("Ghost")
+	RegisterValue[components.GhostRecording]("GhostRecording")
+	RegisterValue[components.Echo]("Echo")
+	RegisterValue[components.Echoes]("Echoes")
+	RegisterValue[components.Sacrifices]("Sacrifices")
+	RegisterValue[components.LevelBounds]("LevelBounds")
+	RegisterValue[components.DifficultyRules]("DifficultyRules")
+	RegisterValue[components.Assist]("Assist")
+	RegisterValue[components.Input]("Input")
+
+	RegisterValue[components.Camera]("Camera")
+	RegisterValue[physics.Config]("PhysicsConfig")
+	RegisterValue[physics.Stats]("PhysicsStats")
+
+	Register("Sprite", encodeSprite, decodeSprite)
+	Register("Collision", encodeCollision, decodeCollision)
+	Register("Animation", encodeAnimation, decodeAnimation)
+	Register("CharacterAnimator", encodeCharacterAnimator, decodeCharacterAnimator)
+	Register("AudioContext",
+		func(_ *Encoder, _ components.AudioContext) (struct{}, error) { return struct{}{}, nil },
+		func(_ *Decoder, _ struct{}) (components.AudioContext, error) {
+			return components.AudioContext{Context: audio.GetContext()}, nil
+		},
+	)
+}
+
+type spriteData struct {
+	Image      string
+	ZIndex     int
+	Origin     linalg.Vector2
+	FlipX      bool
+	FlipY      bool
+	Rotation   float64
+	Scale      linalg.Vector2
+	ColorScale [4]float32
+	Blend      ebiten.Blend
+}
+
+func encodeSprite(e *Encoder, s components.Sprite) (spriteData, error) {
+	ref, err := e.ImageRef(s.Image)
+	if err != nil {
+		return spriteData{}, err
+	}
+
+	return spriteData{
+		Image:      ref,
+		ZIndex:     s.ZIndex,
+		Origin:     s.Origin,
+		FlipX:      s.FlipX,
+		FlipY:      s.FlipY,
+		Rotation:   s.Rotation,
+		Scale:      s.Scale,
+		ColorScale: [4]float32{s.ColorScale.R(), s.ColorScale.G(), s.ColorScale.B(), s.ColorScale.A()},
+		Blend:      s.Blend,
+	}, nil
+}
+
+func decodeSprite(d *Decoder, data spriteData) (components.Sprite, error) {
+	img, err := d.Image(data.Image)
+	if err != nil {
+		return components.Sprite{}, err
+	}
+
+	s := components.Sprite{
+		Image:    img,
+		ZIndex:   data.ZIndex,
+		Origin:   data.Origin,
+		FlipX:    data.FlipX,
+		FlipY:    data.FlipY,
+		Rotation: data.Rotation,
+		Scale:    data.Scale,
+		Blend:    data.Blend,
+	}
+	s.ColorScale.SetR(data.ColorScale[0])
+	s.ColorScale.SetG(data.ColorScale[1])
+	s.ColorScale.SetB(data.ColorScale[2])
+	s.ColorScale.SetA(data.ColorScale[3])
+
+	return s, nil
+}
+
+// shapeData covers the two resolv shapes the game uses; Points are relative
+// to the shape position.
+type shapeData struct {
+	Kind     string
+	X, Y     float64
+	Points   []float64 `json:",omitempty"`
+	Rotation float64   `json:",omitempty"`
+	Scale    linalg.Vector2
+	Radius   float64 `json:",omitempty"`
+}
+
+func encodeCollision(_ *Encoder, c components.Collision) (shapeData, error) {
+	switch shape := c.Shape.(type) {
+	case *resolv.ConvexPolygon:
+		pos := shape.Position()
+		scale := shape.Scale()
+		data := shapeData{
+			Kind:     "polygon",
+			X:        pos.X,
+			Y:        pos.Y,
+			Rotation: shape.Rotation(),
+			Scale:    linalg.Vector2{X: scale.X, Y: scale.Y},
+		}
+		for _, p := range shape.Points {
+			data.Points = append(data.Points, p.X, p.Y)
+		}
+		return data, nil
+	case *resolv.Circle:
+		pos := shape.Position()
+		return shapeData{Kind: "circle", X: pos.X, Y: pos.Y, Radius: shape.Radius()}, nil
+	case nil:
+		return shapeData{}, nil
+	}
+	return shapeData{}, fmt.Errorf("unsupported shape %T", c.Shape)
+}
+
+func decodeCollision(_ *Decoder, data shapeData) (components.Collision, error) {
+	switch data.Kind {
+	case "polygon":
+		shape := resolv.NewConvexPolygon(data.X, data.Y, data.Points)
+		shape.SetRotation(data.Rotation)
+		shape.SetScale(data.Scale.X, data.Scale.Y)
+		return components.Collision{Shape: shape}, nil
+	case "circle":
+		return components.Collision{Shape: resolv.NewCircle(data.X, data.Y, data.Radius)}, nil
+	case "":
+		return components.Collision{}, nil
+	}
+	return components.Collision{}, fmt.Errorf("unsupported shape %q", data.Kind)
+}
+
+type animationData struct {
+	FrameTicks int
+	Images     []string
+}
+
+func encodeAnimation(e *Encoder, a components.Animation) (animationData, error) {
+	data := animationData{FrameTicks: a.FrameTicks}
+	for _, img := range a.Images {
+		ref, err := e.ImageRef(img)
+		if err != nil {
+			return animationData{}, err
+		}
+		data.Images = append(data.Images, ref)
+	}
+	return data, nil
+}
+
+func decodeAnimation(d *Decoder, data animationData) (components.Animation, error) {
+	a := components.Animation{FrameTicks: data.FrameTicks}
+	for _, ref := range data.Images {
+		img, err := d.Image(ref)
+		if err != nil {
+			return components.Animation{}, err
+		}
+		a.Images = append(a.Images, img)
+	}
+	return a, nil
+}
+
+// Clips are not stored: the hero is the only animated character and its
+// clips come from the hero sprite sheet.
+type characterAnimatorData struct {
+	State      components.AnimationState
+	StateTicks int
+	FacingLeft bool
+}
+
+func encodeCharacterAnimator(_ *Encoder, a components.CharacterAnimator) (characterAnimatorData, error) {
+	return characterAnimatorData{
+		State:      a.State,
+		StateTicks: a.StateTicks,
+		FacingLeft: a.FacingLeft,
+	}, nil
+}
+
+func decodeCharacterAnimator(_ *Decoder, data characterAnimatorData) (components.CharacterAnimator, error) {
+	a := assets.NewHeroAnimator()
+	a.State = data.State
+	a.StateTicks = data.StateTicks
+	a.FacingLeft = data.FacingLeft
+	return a, nil
+}
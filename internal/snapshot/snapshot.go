@@ -0,0 +1,151 @@
+package snapshot
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"reflect"
+	"sort"
+
+	"github.com/hajimehoshi/ebiten/v2"
+
+	"github.com/game-jam-2026/dead-jump/internal/ecs"
+)
+
+const Version = 1
+
+// Snapshot is a serializable copy of an ecs.World. Components and resources
+// are keyed by codec name; types without a codec are listed in Skipped.
+type Snapshot struct {
+	Version   int                                         `json:"version"`
+	LastID    ecs.EntityID                                `json:"lastId"`
+	Entities  map[string]map[ecs.EntityID]json.RawMessage `json:"entities"`
+	Resources map[string]json.RawMessage                  `json:"resources"`
+	Images    map[string][]byte                           `json:"images,omitempty"`
+	Skipped   []string                                    `json:"skipped,omitempty"`
+}
+
+func Capture(w *ecs.World) (*Snapshot, error) {
+	s := &Snapshot{
+		Version:   Version,
+		LastID:    w.LastID,
+		Entities:  make(map[string]map[ecs.EntityID]json.RawMessage),
+		Resources: make(map[string]json.RawMessage),
+		Images:    make(map[string][]byte),
+	}
+	enc := &Encoder{snapshot: s, refs: make(map[*ebiten.Image]string)}
+
+	for t, entities := range w.Entities {
+		c, ok := codecsByType[t]
+		if !ok {
+			s.skip(t)
+			continue
+		}
+
+		encoded := make(map[ecs.EntityID]json.RawMessage, len(entities))
+		for e, component := range entities {
+			data, err := encodeValue(enc, c, component)
+			if err != nil {
+				return nil, fmt.Errorf("snapshot: %s of entity %d: %w", c.name, e, err)
+			}
+			encoded[e] = data
+		}
+		s.Entities[c.name] = encoded
+	}
+
+	for t, resource := range w.Resources {
+		c, ok := codecsByType[t]
+		if !ok {
+			s.skip(t)
+			continue
+		}
+
+		data, err := encodeValue(enc, c, resource)
+		if err != nil {
+			return nil, fmt.Errorf("snapshot: resource %s: %w", c.name, err)
+		}
+		s.Resources[c.name] = data
+	}
+
+	sort.Strings(s.Skipped)
+	return s, nil
+}
+
+func encodeValue(enc *Encoder, c *codec, v interface{}) (json.RawMessage, error) {
+	dto, err := c.encode(enc, v)
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(dto)
+}
+
+func (s *Snapshot) skip(t reflect.Type) {
+	s.Skipped = append(s.Skipped, t.String())
+}
+
+// Restore builds a fresh world from the snapshot.
+func (s *Snapshot) Restore() (*ecs.World, error) {
+	if s.Version != Version {
+		return nil, fmt.Errorf("snapshot: unsupported version %d", s.Version)
+	}
+
+	w := ecs.NewWorld()
+	w.LastID = s.LastID
+	dec := &Decoder{snapshot: s, images: make(map[string]*ebiten.Image)}
+
+	for name, entities := range s.Entities {
+		c, ok := codecsByName[name]
+		if !ok {
+			return nil, fmt.Errorf("snapshot: unknown component %q", name)
+		}
+
+		for e, data := range entities {
+			component, err := c.decode(dec, data)
+			if err != nil {
+				return nil, fmt.Errorf("snapshot: %s of entity %d: %w", name, e, err)
+			}
+			w.SetComponent(e, component)
+		}
+	}
+
+	for name, data := range s.Resources {
+		c, ok := codecsByName[name]
+		if !ok {
+			return nil, fmt.Errorf("snapshot: unknown resource %q", name)
+		}
+
+		resource, err := c.decode(dec, data)
+		if err != nil {
+			return nil, fmt.Errorf("snapshot: resource %s: %w", name, err)
+		}
+		w.SetResource(resource)
+	}
+
+	return w, nil
+}
+
+func WriteFile(w *ecs.World, path string) error {
+	s, err := Capture(w)
+	if err != nil {
+		return err
+	}
+
+	data, err := json.Marshal(s)
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(path, data, 0o644)
+}
+
+func ReadFile(path string) (*ecs.World, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	s := &Snapshot{}
+	if err := json.Unmarshal(data, s); err != nil {
+		return nil, fmt.Errorf("snapshot %s: %w", path, err)
+	}
+	return s.Restore()
+}
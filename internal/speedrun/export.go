@@ -0,0 +1,189 @@
+package speedrun
+
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"strconv"
+
+	"github.com/game-jam-2026/dead-jump/internal/save"
+)
+
+const gameName = "Dead Jump"
+
+// Export writes the splits as a LiveSplit .lss file plus JSON and CSV and
+// returns where they went.
+func (t *Timer) Export() ([]string, error) {
+	lss, err := t.LSS()
+	if err != nil {
+		return nil, err
+	}
+	js, err := t.JSON()
+	if err != nil {
+		return nil, err
+	}
+	table, err := t.CSV()
+	if err != nil {
+		return nil, err
+	}
+
+	base := "dead-jump-" + t.Category
+	var paths []string
+	for _, file := range []struct {
+		ext  string
+		data []byte
+	}{{".lss", lss}, {".json", js}, {".csv", table}} {
+		path, err := save.Export(base+file.ext, file.data)
+		if err != nil {
+			return paths, err
+		}
+		paths = append(paths, path)
+	}
+	return paths, nil
+}
+
+type lssTime struct {
+	Name     string `xml:"name,attr,omitempty"`
+	RealTime string `xml:"RealTime,omitempty"`
+	GameTime string `xml:"GameTime,omitempty"`
+}
+
+type lssSegment struct {
+	Name            string    `xml:"Name"`
+	Icon            string    `xml:"Icon"`
+	SplitTimes      []lssTime `xml:"SplitTimes>SplitTime"`
+	BestSegmentTime lssTime   `xml:"BestSegmentTime"`
+	SegmentHistory  string    `xml:"SegmentHistory"`
+}
+
+type lssRun struct {
+	XMLName              xml.Name     `xml:"Run"`
+	Version              string       `xml:"version,attr"`
+	GameIcon             string       `xml:"GameIcon"`
+	GameName             string       `xml:"GameName"`
+	CategoryName         string       `xml:"CategoryName"`
+	Offset               string       `xml:"Offset"`
+	AttemptCount         int          `xml:"AttemptCount"`
+	AttemptHistory       string       `xml:"AttemptHistory"`
+	Segments             []lssSegment `xml:"Segments>Segment"`
+	AutoSplitterSettings string       `xml:"AutoSplitterSettings"`
+}
+
+// LSS renders the personal best in LiveSplit's splits format. Times are
+// both real and game time since the timer only counts ticks.
+func (t *Timer) LSS() ([]byte, error) {
+	record := t.Record()
+
+	run := lssRun{
+		Version:      "1.7.0",
+		GameName:     gameName,
+		CategoryName: t.Category,
+		Offset:       "00:00:00",
+		AttemptCount: record.Attempts,
+	}
+
+	for _, split := range t.comparison() {
+		segment := lssSegment{
+			Name: split.Name,
+			SplitTimes: []lssTime{{
+				Name:     "Personal Best",
+				RealTime: lssDuration(split.Ticks),
+				GameTime: lssDuration(split.Ticks),
+			}},
+		}
+		if gold, ok := record.Gold[split.Name]; ok {
+			segment.BestSegmentTime = lssTime{RealTime: lssDuration(gold), GameTime: lssDuration(gold)}
+		}
+		run.Segments = append(run.Segments, segment)
+	}
+
+	data, err := xml.MarshalIndent(run, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+	return append([]byte(xml.Header), data...), nil
+}
+
+type jsonSplit struct {
+	Name      string `json:"name"`
+	Ticks     int    `json:"ticks"`
+	Time      string `json:"time"`
+	PBTicks   int    `json:"pb_ticks,omitempty"`
+	GoldTicks int    `json:"gold_ticks,omitempty"`
+}
+
+// JSON lists the last run's splits (or the PB when no run was finished)
+// next to the PB and best segments.
+func (t *Timer) JSON() ([]byte, error) {
+	return json.MarshalIndent(struct {
+		Game     string      `json:"game"`
+		Category string      `json:"category"`
+		Attempts int         `json:"attempts"`
+		Splits   []jsonSplit `json:"splits"`
+	}{
+		Game:     gameName,
+		Category: t.Category,
+		Attempts: t.Record().Attempts,
+		Splits:   t.rows(),
+	}, "", "  ")
+}
+
+// CSV is the same table as JSON, one split per line.
+func (t *Timer) CSV() ([]byte, error) {
+	var buf bytes.Buffer
+	w := csv.NewWriter(&buf)
+	_ = w.Write([]string{"name", "ticks", "time", "pb_ticks", "gold_ticks"})
+	for _, row := range t.rows() {
+		_ = w.Write([]string{
+			row.Name,
+			strconv.Itoa(row.Ticks),
+			row.Time,
+			strconv.Itoa(row.PBTicks),
+			strconv.Itoa(row.GoldTicks),
+		})
+	}
+	w.Flush()
+	return buf.Bytes(), w.Error()
+}
+
+func (t *Timer) rows() []jsonSplit {
+	record := t.Record()
+
+	splits := t.Splits
+	if len(splits) == 0 {
+		splits = record.Best
+	}
+
+	rows := make([]jsonSplit, 0, len(splits))
+	for i, split := range splits {
+		row := jsonSplit{
+			Name:      split.Name,
+			Ticks:     split.Ticks,
+			Time:      FormatTicks(split.Ticks),
+			GoldTicks: record.Gold[split.Name],
+		}
+		if i < len(record.Best) && record.Best[i].Name == split.Name {
+			row.PBTicks = record.Best[i].Ticks
+		}
+		rows = append(rows, row)
+	}
+	return rows
+}
+
+// comparison is the PB, or the current run while there is none.
+func (t *Timer) comparison() []Split {
+	if best := t.Record().Best; len(best) > 0 {
+		return best
+	}
+	return t.Splits
+}
+
+// lssDuration formats ticks as LiveSplit's hh:mm:ss.fffffff.
+func lssDuration(ticks int) string {
+	nanos := int64(ticks) * 1e9 / TicksPerSecond
+	total := nanos / 100
+	return fmt.Sprintf("%02d:%02d:%02d.%07d",
+		total/(36e9), total/(6e8)%60, total/1e7%60, total%1e7)
+}
@@ -0,0 +1,25 @@
+package speedrun
+
+import "fmt"
+
+const TicksPerSecond = 60
+
+// FormatTicks formats a tick count as m:ss.cc.
+func FormatTicks(ticks int) string {
+	centis := ticks * 100 / TicksPerSecond
+	return fmt.Sprintf("%d:%02d.%02d", centis/6000, centis/100%60, centis%100)
+}
+
+// FormatDelta formats a PB comparison with its sign, seconds only.
+func FormatDelta(ticks int) string {
+	sign := "+"
+	if ticks < 0 {
+		sign = "-"
+		ticks = -ticks
+	}
+	centis := ticks * 100 / TicksPerSecond
+	if centis >= 6000 {
+		return fmt.Sprintf("%s%d:%02d.%d", sign, centis/6000, centis/100%60, centis%100/10)
+	}
+	return fmt.Sprintf("%s%d.%02d", sign, centis/100, centis%100)
+}
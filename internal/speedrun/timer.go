@@ -0,0 +1,167 @@
+// Package speedrun times runs through the level sequence in simulation ticks
+// and keeps per-level splits and the personal best.
+package speedrun
+
+import (
+	"fmt"
+
+	"github.com/game-jam-2026/dead-jump/internal/save"
+)
+
+const recordsName = "speedrun"
+
+type Split struct {
+	Name string `json:"name"`
+	// Ticks is the run time when the level was finished.
+	Ticks int `json:"ticks"`
+}
+
+// Record is everything kept for one category between sessions.
+type Record struct {
+	Attempts int     `json:"attempts"`
+	Best     []Split `json:"best,omitempty"`
+	// Gold is the fastest time of each segment in any attempt.
+	Gold map[string]int `json:"gold,omitempty"`
+}
+
+func (r *Record) Total() int {
+	if len(r.Best) == 0 {
+		return 0
+	}
+	return r.Best[len(r.Best)-1].Ticks
+}
+
+type Timer struct {
+	Category string
+	Ticks    int
+	Splits   []Split
+	Running  bool
+	Finished bool
+
+	records map[string]*Record
+}
+
+func NewTimer() *Timer {
+	t := &Timer{records: make(map[string]*Record)}
+	if err := save.Load(recordsName, &t.records); err != nil {
+		fmt.Println("speedrun: ignoring broken records:", err)
+		t.records = make(map[string]*Record)
+	}
+	return t
+}
+
+// Record returns the saved record of the current category.
+func (t *Timer) Record() *Record {
+	r, ok := t.records[t.Category]
+	if !ok {
+		r = &Record{Gold: make(map[string]int)}
+		t.records[t.Category] = r
+	}
+	if r.Gold == nil {
+		r.Gold = make(map[string]int)
+	}
+	return r
+}
+
+// Start begins a new attempt in category.
+func (t *Timer) Start(category string) {
+	t.Category = category
+	t.Ticks = 0
+	t.Splits = nil
+	t.Running = true
+	t.Finished = false
+
+	t.Record().Attempts++
+	t.store()
+}
+
+func (t *Timer) Stop() {
+	t.Running = false
+}
+
+func (t *Timer) Tick() {
+	if t.Running {
+		t.Ticks++
+	}
+}
+
+// Split closes the segment of the level just finished.
+func (t *Timer) Split(name string) {
+	if !t.Running {
+		return
+	}
+
+	t.Splits = append(t.Splits, Split{Name: name, Ticks: t.Ticks})
+
+	record := t.Record()
+	segment := t.segment(len(t.Splits) - 1)
+	if gold, ok := record.Gold[name]; !ok || segment < gold {
+		record.Gold[name] = segment
+	}
+	t.store()
+}
+
+// Finish stops the run and keeps it as the personal best if it is faster.
+// It returns true for a new PB.
+func (t *Timer) Finish() bool {
+	if !t.Running {
+		return false
+	}
+	t.Running = false
+	t.Finished = true
+
+	record := t.Record()
+	pb := len(record.Best) == 0 || len(t.Splits) > len(record.Best) ||
+		(len(t.Splits) == len(record.Best) && t.Ticks < record.Total())
+	if pb {
+		record.Best = append([]Split(nil), t.Splits...)
+	}
+
+	t.store()
+	return pb
+}
+
+// Delta compares the current time against the PB split of the level being
+// played: negative is ahead. ok is false without a PB to compare to.
+func (t *Timer) Delta() (delta int, ok bool) {
+	best := t.Record().Best
+	i := len(t.Splits)
+	if t.Finished {
+		i--
+	}
+	if i < 0 || i >= len(best) {
+		return 0, false
+	}
+	return t.Ticks - best[i].Ticks, true
+}
+
+// SplitDelta compares split i against the PB.
+func (t *Timer) SplitDelta(i int) (delta int, ok bool) {
+	best := t.Record().Best
+	if i < 0 || i >= len(t.Splits) || i >= len(best) || best[i].Name != t.Splits[i].Name {
+		return 0, false
+	}
+	return t.Splits[i].Ticks - best[i].Ticks, true
+}
+
+// IsGold reports whether split i was the fastest segment ever for its level.
+func (t *Timer) IsGold(i int) bool {
+	if i < 0 || i >= len(t.Splits) {
+		return false
+	}
+	gold, ok := t.Record().Gold[t.Splits[i].Name]
+	return ok && t.segment(i) <= gold
+}
+
+func (t *Timer) segment(i int) int {
+	if i == 0 {
+		return t.Splits[0].Ticks
+	}
+	return t.Splits[i].Ticks - t.Splits[i-1].Ticks
+}
+
+func (t *Timer) store() {
+	if err := save.Store(recordsName, t.records); err != nil {
+		fmt.Println("speedrun:", err)
+	}
+}
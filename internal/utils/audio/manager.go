@@ -15,6 +15,7 @@ type Manager struct {
 	sounds       map[SoundID][][]byte
 	musicPlayers map[SoundID]*audio.Player
 	muted        bool
+	quietSFX     bool
 	masterVolume float64
 	musicVolume  float64
 	sfxVolume    float64
@@ -61,6 +62,15 @@ func RegisterWAV(id SoundID, data []byte) error {
 	return nil
 }
 
+// RegisterPCM adds already decoded 16-bit stereo PCM at the context sample
+// rate, e.g. synthesized sounds.
+func RegisterPCM(id SoundID, data []byte) {
+	if defaultManager == nil {
+		Init()
+	}
+	defaultManager.sounds[id] = append(defaultManager.sounds[id], data)
+}
+
 func RegisterMP3(id SoundID, data []byte) error {
 	if defaultManager == nil {
 		Init()
@@ -112,7 +122,7 @@ func RegisterMusicMP3(id SoundID, data []byte) error {
 }
 
 func Play(id SoundID) {
-	if defaultManager == nil || defaultManager.muted {
+	if defaultManager == nil || defaultManager.muted || defaultManager.quietSFX {
 		return
 	}
 	variants := defaultManager.sounds[id]
@@ -201,6 +211,15 @@ func SetMuted(muted bool) {
 	}
 }
 
+// SetQuietSFX silences sound effects while music keeps playing, e.g. for
+// the attract mode demo behind the title screen.
+func SetQuietSFX(quiet bool) {
+	if defaultManager == nil {
+		return
+	}
+	defaultManager.quietSFX = quiet
+}
+
 func GetMasterVolume() float64 {
 	if defaultManager == nil {
 		return 1.0
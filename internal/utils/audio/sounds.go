@@ -8,6 +8,7 @@ const (
 	SoundMenuConfirm
 
 	SoundCannonShot
+	SoundCannonCharge
 	SoundProjectileHit
 	SoundDeath
 	SoundGameOver
@@ -15,4 +16,9 @@ const (
 
 	SoundLevelMusic
 	SoundStep
+	SoundPickup
+	SoundDeathCrush
+	SoundDeathBurn
+	SoundDeathFall
+	SoundDeathSacrifice
 )
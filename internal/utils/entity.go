@@ -8,7 +8,6 @@ import (
 
 	"github.com/game-jam-2026/dead-jump/internal/ecs"
 	"github.com/game-jam-2026/dead-jump/internal/ecs/components"
-	"github.com/game-jam-2026/dead-jump/internal/utils/audio"
 	"github.com/game-jam-2026/dead-jump/pkg/linalg"
 )
 
@@ -19,38 +18,53 @@ func KillEntity(
 	scale float64,
 	createCharacterFunc func(w *ecs.World, x, y float64, scale float64) ecs.EntityID,
 ) {
-	audio.Play(audio.SoundDeath)
-
-	pos, _ := ecs.GetComponent[components.Position](w, entity)
-
 	err := w.RemoveComponent(entity, components.Character{})
 	if err != nil {
 		panic(err)
 	}
-	err = w.RemoveComponent(entity, components.Velocity{})
+	MakeCorpse(w, entity, deadImage, scale)
+
+	startPoints := w.GetEntities(reflect.TypeOf((*components.StartPoint)(nil)).Elem())
+	if len(startPoints) > 0 {
+		spPos, _ := ecs.GetComponent[components.Position](w, startPoints[0])
+		createCharacterFunc(w, spPos.Vector.X, spPos.Vector.Y, scale)
+	}
+
+	lifeCounters := w.GetEntities(reflect.TypeOf((*components.Life)(nil)).Elem())
+	if len(lifeCounters) > 0 {
+		life, _ := ecs.GetComponent[components.Life](w, lifeCounters[0])
+		life.Count += -1
+		w.SetComponent(lifeCounters[0], *life)
+	}
+}
+
+// MakeCorpse turns a body into a static corpse where it stands.
+func MakeCorpse(w *ecs.World, entity ecs.EntityID, deadImage *ebiten.Image, scale float64) {
+	pos, _ := ecs.GetComponent[components.Position](w, entity)
+
+	err := w.RemoveComponent(entity, components.Velocity{})
 	if err != nil {
 		panic(err)
 	}
 	_ = w.RemoveComponent(entity, components.PhysicsBody{})
 
+	durability := int64(-1)
+	if rules, err := ecs.GetResource[components.DifficultyRules](w); err == nil {
+		durability = rules.CorpseDurability
+	}
 	w.SetComponent(entity, components.Corpse{
-		Durability: 5,
+		Durability: durability,
 	})
 
 	w.SetComponent(entity, components.StaticBody())
 
-	bounds := deadImage.Bounds()
-	width := float64(bounds.Dx()) * scale
-	height := float64(bounds.Dy()) * scale
-
-	scaledImg := ebiten.NewImage(int(width), int(height))
-	op := &ebiten.DrawImageOptions{}
-	op.GeoM.Scale(scale, scale)
-	scaledImg.DrawImage(deadImage, op)
+	sprite := components.Sprite{
+		Image: deadImage,
+	}
+	sprite.SetUniformScale(scale)
+	w.SetComponent(entity, sprite)
 
-	w.SetComponent(entity, components.Sprite{
-		Image: scaledImg,
-	})
+	width, height := sprite.Size()
 
 	newPosY := pos.Vector.Y + 10
 
@@ -62,17 +76,4 @@ func KillEntity(
 		Y: newPosY,
 	}
 	w.SetComponent(entity, components.Position{Vector: newVec})
-
-	startPoints := w.GetEntities(reflect.TypeOf((*components.StartPoint)(nil)).Elem())
-	if len(startPoints) > 0 {
-		spPos, _ := ecs.GetComponent[components.Position](w, startPoints[0])
-		createCharacterFunc(w, spPos.Vector.X, spPos.Vector.Y, scale)
-	}
-
-	lifeCounters := w.GetEntities(reflect.TypeOf((*components.Life)(nil)).Elem())
-	if len(lifeCounters) > 0 {
-		life, _ := ecs.GetComponent[components.Life](w, lifeCounters[0])
-		life.Count += -1
-		w.SetComponent(lifeCounters[0], *life)
-	}
 }